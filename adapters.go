@@ -0,0 +1,245 @@
+package hof
+
+import "iter"
+
+// Sequence Adapters
+//
+// Adapters stop pulling from upstream as soon as their result is decided.
+
+// Take : First n elements
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+// TakeLast : Last n elements; consumes the whole upstream before yielding
+func TakeLast[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		ring := make([]T, 0, n)
+		start := 0
+		for v := range seq {
+			if len(ring) < n {
+				ring = append(ring, v)
+				continue
+			}
+			ring[start] = v
+			start = (start + 1) % n
+		}
+		for i := range ring {
+			if !yield(ring[(start+i)%len(ring)]) {
+				return
+			}
+		}
+	}
+}
+
+// Drop : Skip the first n elements
+func Drop[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile : Elements up to the first that fails the condition
+func TakeWhile[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !fn(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile : Skip elements until the first that fails the condition
+func DropWhile[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && fn(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// StepBy : Every step-th element starting with the first; a step below 1 yields nothing
+func StepBy[T any](seq iter.Seq[T], step int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if step < 1 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if i%step == 0 && !yield(v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Nth : Element at index n, if the sequence is long enough
+func Nth[T any](seq iter.Seq[T], n int) (T, bool) {
+	var zero T
+	if n < 0 {
+		return zero, false
+	}
+	i := 0
+	for v := range seq {
+		if i == n {
+			return v, true
+		}
+		i++
+	}
+	return zero, false
+}
+
+// Take2 : First n pairs
+func Take2[K, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for k, v := range seq {
+			if !yield(k, v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+// TakeLast2 : Last n pairs; consumes the whole upstream before yielding
+func TakeLast2[K, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if n <= 0 {
+			return
+		}
+		keys := make([]K, 0, n)
+		vals := make([]V, 0, n)
+		start := 0
+		for k, v := range seq {
+			if len(keys) < n {
+				keys = append(keys, k)
+				vals = append(vals, v)
+				continue
+			}
+			keys[start], vals[start] = k, v
+			start = (start + 1) % n
+		}
+		for i := range keys {
+			j := (start + i) % len(keys)
+			if !yield(keys[j], vals[j]) {
+				return
+			}
+		}
+	}
+}
+
+// Drop2 : Skip the first n pairs
+func Drop2[K, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		i := 0
+		for k, v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile2 : Pairs up to the first that fails the condition
+func TakeWhile2[K, V any](seq iter.Seq2[K, V], fn func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if !fn(k, v) || !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile2 : Skip pairs until the first that fails the condition
+func DropWhile2[K, V any](seq iter.Seq2[K, V], fn func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		dropping := true
+		for k, v := range seq {
+			if dropping && fn(k, v) {
+				continue
+			}
+			dropping = false
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// StepBy2 : Every step-th pair starting with the first; a step below 1 yields nothing
+func StepBy2[K, V any](seq iter.Seq2[K, V], step int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if step < 1 {
+			return
+		}
+		i := 0
+		for k, v := range seq {
+			if i%step == 0 && !yield(k, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Nth2 : Pair at index n, if the sequence is long enough
+func Nth2[K, V any](seq iter.Seq2[K, V], n int) (K, V, bool) {
+	var zeroK K
+	var zeroV V
+	if n < 0 {
+		return zeroK, zeroV, false
+	}
+	i := 0
+	for k, v := range seq {
+		if i == n {
+			return k, v, true
+		}
+		i++
+	}
+	return zeroK, zeroV, false
+}
@@ -0,0 +1,253 @@
+package hof_test
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+// countingSeq yields 0, 1, 2, ... up to n-1 and records how many values were pulled.
+func countingSeq(n int, pulled *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range n {
+			*pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// countingSeq2 yields (i, i*i) pairs and records how many were pulled.
+func countingSeq2(n int, pulled *int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := range n {
+			*pulled++
+			if !yield(i, i*i) {
+				return
+			}
+		}
+	}
+}
+
+func collect2[K, V any](seq iter.Seq2[K, V]) [][2]any {
+	var out [][2]any
+	for k, v := range seq {
+		out = append(out, [2]any{k, v})
+	}
+	return out
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name   string
+		n      int
+		want   []int
+		pulled int
+	}{
+		{"take three", 3, []int{0, 1, 2}, 3},
+		{"take more than available", 20, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 10},
+		{"take zero", 0, nil, 0},
+		{"take negative", -1, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pulled := 0
+			got := slices.Collect(hof.Take(countingSeq(10, &pulled), tt.n))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+			if pulled != tt.pulled {
+				t.Errorf("pulled %d elements, want %d", pulled, tt.pulled)
+			}
+		})
+	}
+
+	t.Run("infinite source", func(t *testing.T) {
+		got := slices.Collect(hof.Take(hof.Iterate(1, func(n int) int { return n * 3 }), 4))
+		if !reflect.DeepEqual(got, []int{1, 3, 9, 27}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+}
+
+func TestTakeLast(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{"last three", 3, []int{7, 8, 9}},
+		{"more than available", 20, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"zero", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pulled := 0
+			got := slices.Collect(hof.TakeLast(countingSeq(10, &pulled), tt.n))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDrop(t *testing.T) {
+	pulled := 0
+	got := slices.Collect(hof.Take(hof.Drop(countingSeq(100, &pulled), 5), 2))
+
+	if !reflect.DeepEqual(got, []int{5, 6}) {
+		t.Errorf("got:%v", got)
+	}
+	if pulled != 7 {
+		t.Errorf("pulled %d elements, want 7", pulled)
+	}
+	if got := slices.Collect(hof.Drop(slices.Values([]int{1, 2}), 5)); got != nil {
+		t.Errorf("Drop past end = %v, want empty", got)
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	pulled := 0
+	got := slices.Collect(hof.TakeWhile(countingSeq(100, &pulled), func(n int) bool { return n < 4 }))
+
+	if !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+		t.Errorf("got:%v", got)
+	}
+	if pulled != 5 {
+		t.Errorf("pulled %d elements, want 5", pulled)
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	got := slices.Collect(hof.DropWhile(slices.Values([]int{1, 2, 5, 1, 7}), func(n int) bool { return n < 3 }))
+
+	if !reflect.DeepEqual(got, []int{5, 1, 7}) {
+		t.Errorf("got:%v", got)
+	}
+}
+
+func TestStepBy(t *testing.T) {
+	tests := []struct {
+		name string
+		step int
+		want []int
+	}{
+		{"every third", 3, []int{0, 3, 6, 9}},
+		{"step one", 1, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"zero step", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pulled := 0
+			got := slices.Collect(hof.StepBy(countingSeq(10, &pulled), tt.step))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		pulled := 0
+		got := slices.Collect(hof.Take(hof.StepBy(countingSeq(100, &pulled), 4), 2))
+		if !reflect.DeepEqual(got, []int{0, 4}) || pulled != 5 {
+			t.Errorf("got:%v after pulling %d", got, pulled)
+		}
+	})
+}
+
+func TestNth(t *testing.T) {
+	pulled := 0
+	v, ok := hof.Nth(countingSeq(100, &pulled), 3)
+
+	if !ok || v != 3 || pulled != 4 {
+		t.Errorf("Nth(3) = %v, %v after pulling %d", v, ok, pulled)
+	}
+	if _, ok := hof.Nth(slices.Values([]int{1}), 1); ok {
+		t.Errorf("Nth past end should report false")
+	}
+	if _, ok := hof.Nth(slices.Values([]int{1}), -1); ok {
+		t.Errorf("Nth(-1) should report false")
+	}
+}
+
+func TestSeq2Adapters(t *testing.T) {
+	t.Run("take2", func(t *testing.T) {
+		pulled := 0
+		got := collect2(hof.Take2(countingSeq2(10, &pulled), 2))
+		want := [][2]any{{0, 0}, {1, 1}}
+
+		if !reflect.DeepEqual(got, want) || pulled != 2 {
+			t.Errorf("got:%v after pulling %d", got, pulled)
+		}
+	})
+
+	t.Run("take last2", func(t *testing.T) {
+		pulled := 0
+		got := collect2(hof.TakeLast2(countingSeq2(5, &pulled), 2))
+		want := [][2]any{{3, 9}, {4, 16}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("drop2", func(t *testing.T) {
+		pulled := 0
+		got := collect2(hof.Drop2(countingSeq2(4, &pulled), 2))
+		want := [][2]any{{2, 4}, {3, 9}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("take while2", func(t *testing.T) {
+		pulled := 0
+		got := collect2(hof.TakeWhile2(countingSeq2(100, &pulled), func(k, v int) bool { return v < 5 }))
+		want := [][2]any{{0, 0}, {1, 1}, {2, 4}}
+
+		if !reflect.DeepEqual(got, want) || pulled != 4 {
+			t.Errorf("got:%v after pulling %d", got, pulled)
+		}
+	})
+
+	t.Run("drop while2", func(t *testing.T) {
+		pulled := 0
+		got := collect2(hof.DropWhile2(countingSeq2(5, &pulled), func(k, v int) bool { return k < 3 }))
+		want := [][2]any{{3, 9}, {4, 16}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("step by2", func(t *testing.T) {
+		got := collect2(hof.StepBy2(slices.All([]string{"a", "b", "c", "d", "e"}), 2))
+		want := [][2]any{{0, "a"}, {2, "c"}, {4, "e"}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("nth2", func(t *testing.T) {
+		pulled := 0
+		k, v, ok := hof.Nth2(countingSeq2(100, &pulled), 5)
+
+		if !ok || k != 5 || v != 25 || pulled != 6 {
+			t.Errorf("Nth2(5) = %v, %v, %v after pulling %d", k, v, ok, pulled)
+		}
+		if _, _, ok := hof.Nth2(maps.All(map[string]int{}), 0); ok {
+			t.Errorf("Nth2 on empty should report false")
+		}
+	})
+}
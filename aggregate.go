@@ -0,0 +1,148 @@
+package hof
+
+import "cmp"
+
+// Grouped Aggregation
+
+// GroupReduce : Fold each group into an accumulator without storing its members
+//
+// Every group starts from a copy of init, so init should not share mutable
+// state such as a map or a slice with spare capacity.
+func GroupReduce[T any, K comparable, Acc any](arr []T, keyFn func(T) K, reducer func(Acc, T) Acc, init Acc) map[K]Acc {
+	groups := make(map[K]Acc)
+	for _, v := range arr {
+		key := keyFn(v)
+		acc, ok := groups[key]
+		if !ok {
+			acc = init
+		}
+		groups[key] = reducer(acc, v)
+	}
+	return groups
+}
+
+// Aggregator : Factory for a fresh per-group accumulation, returning a step and a result function
+type Aggregator[T any] func() (step func(T), result func() any)
+
+// AggregateBy : Compute several named aggregates per group in a single pass
+func AggregateBy[T any, K comparable](arr []T, keyFn func(T) K, aggs map[string]Aggregator[T]) map[K]map[string]any {
+	type state struct {
+		steps   []func(T)
+		results map[string]func() any
+	}
+
+	groups := make(map[K]*state)
+	for _, v := range arr {
+		key := keyFn(v)
+		st, ok := groups[key]
+		if !ok {
+			st = &state{results: make(map[string]func() any, len(aggs))}
+			for name, agg := range aggs {
+				step, result := agg()
+				st.steps = append(st.steps, step)
+				st.results[name] = result
+			}
+			groups[key] = st
+		}
+		for _, step := range st.steps {
+			step(v)
+		}
+	}
+
+	out := make(map[K]map[string]any, len(groups))
+	for key, st := range groups {
+		row := make(map[string]any, len(st.results))
+		for name, result := range st.results {
+			row[name] = result()
+		}
+		out[key] = row
+	}
+	return out
+}
+
+// CountAgg : Aggregate the number of elements as an int
+func CountAgg[T any]() Aggregator[T] {
+	return func() (func(T), func() any) {
+		n := 0
+		return func(T) { n++ }, func() any { return n }
+	}
+}
+
+// SumAgg : Aggregate the sum of fn over the elements
+func SumAgg[T any, N Number](fn func(T) N) Aggregator[T] {
+	return func() (func(T), func() any) {
+		var sum N
+		return func(v T) { sum += fn(v) }, func() any { return sum }
+	}
+}
+
+// MinAgg : Aggregate the smallest fn value, or the zero value when empty
+func MinAgg[T any, N cmp.Ordered](fn func(T) N) Aggregator[T] {
+	return func() (func(T), func() any) {
+		var min_ N
+		seen := false
+		step := func(v T) {
+			if x := fn(v); !seen || x < min_ {
+				min_, seen = x, true
+			}
+		}
+		return step, func() any { return min_ }
+	}
+}
+
+// MaxAgg : Aggregate the largest fn value, or the zero value when empty
+func MaxAgg[T any, N cmp.Ordered](fn func(T) N) Aggregator[T] {
+	return func() (func(T), func() any) {
+		var max_ N
+		seen := false
+		step := func(v T) {
+			if x := fn(v); !seen || x > max_ {
+				max_, seen = x, true
+			}
+		}
+		return step, func() any { return max_ }
+	}
+}
+
+// AvgAgg : Aggregate the mean of fn as a float64
+func AvgAgg[T any, N Number](fn func(T) N) Aggregator[T] {
+	return func() (func(T), func() any) {
+		var sum N
+		n := 0
+		step := func(v T) {
+			sum += fn(v)
+			n++
+		}
+		return step, func() any { return float64(sum) / float64(n) }
+	}
+}
+
+// FirstAgg : Aggregate the first element seen
+func FirstAgg[T any]() Aggregator[T] {
+	return func() (func(T), func() any) {
+		var first T
+		seen := false
+		step := func(v T) {
+			if !seen {
+				first, seen = v, true
+			}
+		}
+		return step, func() any { return first }
+	}
+}
+
+// LastAgg : Aggregate the last element seen
+func LastAgg[T any]() Aggregator[T] {
+	return func() (func(T), func() any) {
+		var last T
+		return func(v T) { last = v }, func() any { return last }
+	}
+}
+
+// CollectAgg : Aggregate fn of every element into a slice
+func CollectAgg[T, V any](fn func(T) V) Aggregator[T] {
+	return func() (func(T), func() any) {
+		var out []V
+		return func(v T) { out = append(out, fn(v)) }, func() any { return out }
+	}
+}
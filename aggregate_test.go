@@ -0,0 +1,126 @@
+package hof_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+type sale struct {
+	Region string
+	Item   string
+	Amount int
+}
+
+var sales = []sale{
+	{"north", "tea", 10},
+	{"south", "coffee", 25},
+	{"north", "coffee", 30},
+	{"south", "tea", 5},
+	{"north", "cake", 20},
+}
+
+func saleRegion(s sale) string { return s.Region }
+
+func TestGroupReduce(t *testing.T) {
+	t.Run("sum per group", func(t *testing.T) {
+		got := hof.GroupReduce(sales, saleRegion, func(acc int, s sale) int { return acc + s.Amount }, 0)
+		want := map[string]int{"north": 60, "south": 30}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GroupReduce() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("init used per group", func(t *testing.T) {
+		got := hof.GroupReduce([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 }, func(acc, n int) int { return acc * n }, 1)
+		want := map[bool]int{false: 3, true: 8}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GroupReduce() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		got := hof.GroupReduce([]sale{}, saleRegion, func(acc int, s sale) int { return acc + 1 }, 0)
+		if len(got) != 0 {
+			t.Errorf("GroupReduce() = %v, want empty", got)
+		}
+	})
+}
+
+func TestAggregateBy(t *testing.T) {
+	amount := func(s sale) int { return s.Amount }
+	item := func(s sale) string { return s.Item }
+
+	got := hof.AggregateBy(sales, saleRegion, map[string]hof.Aggregator[sale]{
+		"count": hof.CountAgg[sale](),
+		"sum":   hof.SumAgg(amount),
+		"min":   hof.MinAgg(amount),
+		"max":   hof.MaxAgg(amount),
+		"avg":   hof.AvgAgg(amount),
+		"first": hof.FirstAgg[sale](),
+		"last":  hof.LastAgg[sale](),
+		"items": hof.CollectAgg(item),
+	})
+
+	want := map[string]map[string]any{
+		"north": {
+			"count": 3,
+			"sum":   60,
+			"min":   10,
+			"max":   30,
+			"avg":   20.0,
+			"first": sales[0],
+			"last":  sales[4],
+			"items": []string{"tea", "coffee", "cake"},
+		},
+		"south": {
+			"count": 2,
+			"sum":   30,
+			"min":   5,
+			"max":   25,
+			"avg":   15.0,
+			"first": sales[1],
+			"last":  sales[3],
+			"items": []string{"coffee", "tea"},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestAggregatorsOnEmptyInput(t *testing.T) {
+	ident := func(n float64) float64 { return n }
+	tests := []struct {
+		name string
+		agg  hof.Aggregator[float64]
+		want any
+	}{
+		{"count", hof.CountAgg[float64](), 0},
+		{"sum", hof.SumAgg(ident), 0.0},
+		{"min", hof.MinAgg(ident), 0.0},
+		{"max", hof.MaxAgg(ident), 0.0},
+		{"first", hof.FirstAgg[float64](), 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, result := tt.agg()
+			if got := result(); got != tt.want {
+				t.Errorf("result() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("avg", func(t *testing.T) {
+		_, result := hof.AvgAgg(ident)()
+		if got := result().(float64); !math.IsNaN(got) {
+			t.Errorf("result() = %v, want NaN", got)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package hof
+
+import (
+	"iter"
+	"slices"
+)
+
+// Counting
+
+// CountBy : Count elements per key without materializing groups
+func CountBy[T any, K comparable](arr []T, keyFn func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, v := range arr {
+		counts[keyFn(v)]++
+	}
+	return counts
+}
+
+// Frequencies : Count occurrences of each element
+func Frequencies[T comparable](arr []T) map[T]int {
+	counts := make(map[T]int)
+	for _, v := range arr {
+		counts[v]++
+	}
+	return counts
+}
+
+// Bag : Multiset counting occurrences, remembering first-insertion order
+type Bag[T comparable] struct {
+	counts map[T]int
+	order  []T
+}
+
+// NewBag : Build a bag from the given values
+func NewBag[T comparable](vals ...T) *Bag[T] {
+	b := &Bag[T]{counts: make(map[T]int)}
+	for _, v := range vals {
+		b.Add(v)
+	}
+	return b
+}
+
+// Add : Insert one occurrence of v
+func (b *Bag[T]) Add(v T) {
+	b.AddN(v, 1)
+}
+
+// AddN : Insert n occurrences of v
+func (b *Bag[T]) AddN(v T, n int) {
+	if n <= 0 {
+		return
+	}
+	if b.counts == nil {
+		b.counts = make(map[T]int)
+	}
+	if _, ok := b.counts[v]; !ok {
+		b.order = append(b.order, v)
+	}
+	b.counts[v] += n
+}
+
+// Remove : Delete one occurrence of v, reporting whether it was present
+func (b *Bag[T]) Remove(v T) bool {
+	n, ok := b.counts[v]
+	if !ok {
+		return false
+	}
+	if n > 1 {
+		b.counts[v] = n - 1
+		return true
+	}
+	delete(b.counts, v)
+	b.order = slices.DeleteFunc(b.order, func(x T) bool { return x == v })
+	return true
+}
+
+// Count : Occurrences of v
+func (b *Bag[T]) Count(v T) int {
+	return b.counts[v]
+}
+
+// Len : Total number of occurrences
+func (b *Bag[T]) Len() int {
+	total := 0
+	for _, n := range b.counts {
+		total += n
+	}
+	return total
+}
+
+// Distinct : Number of distinct values
+func (b *Bag[T]) Distinct() int {
+	return len(b.counts)
+}
+
+// All : Iterate over values and their counts in first-insertion order
+func (b *Bag[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for _, v := range b.order {
+			if !yield(v, b.counts[v]) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon : The n most frequent values, ties broken by first insertion; n <= 0 returns all
+func (b *Bag[T]) MostCommon(n int) []Entry[T, int] {
+	entries := make([]Entry[T, int], 0, len(b.order))
+	for _, v := range b.order {
+		entries = append(entries, Entry[T, int]{Key: v, Value: b.counts[v]})
+	}
+	slices.SortStableFunc(entries, func(x, y Entry[T, int]) int {
+		return y.Value - x.Value
+	})
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// Union : Bag holding the larger count of each value
+func (b *Bag[T]) Union(other *Bag[T]) *Bag[T] {
+	out := NewBag[T]()
+	for v, n := range b.All() {
+		out.AddN(v, max(n, other.Count(v)))
+	}
+	for v, n := range other.All() {
+		if out.Count(v) == 0 {
+			out.AddN(v, n)
+		}
+	}
+	return out
+}
+
+// Intersection : Bag holding the smaller count of each value
+func (b *Bag[T]) Intersection(other *Bag[T]) *Bag[T] {
+	out := NewBag[T]()
+	for v, n := range b.All() {
+		out.AddN(v, min(n, other.Count(v)))
+	}
+	return out
+}
+
+// Sum : Bag holding the combined counts of both bags
+func (b *Bag[T]) Sum(other *Bag[T]) *Bag[T] {
+	out := NewBag[T]()
+	for _, src := range []*Bag[T]{b, other} {
+		for v, n := range src.All() {
+			out.AddN(v, n)
+		}
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+package hof_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestCountBy(t *testing.T) {
+	t.Run("count by length", func(t *testing.T) {
+		words := []string{"cat", "dog", "bird", "fish", "elephant"}
+		got := hof.CountBy(words, func(s string) int { return len(s) })
+		want := map[int]int{3: 2, 4: 2, 8: 1}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CountBy() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		got := hof.CountBy([]int{}, func(n int) bool { return n > 0 })
+		if len(got) != 0 {
+			t.Errorf("CountBy() = %v, want empty", got)
+		}
+	})
+}
+
+func TestFrequencies(t *testing.T) {
+	fruits := []string{"apple", "banana", "apple", "orange", "apple"}
+	got := hof.Frequencies(fruits)
+	want := map[string]int{"apple": 3, "banana": 1, "orange": 1}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Frequencies() = %v, want %v", got, want)
+	}
+}
+
+func TestBag(t *testing.T) {
+	t.Run("add and count", func(t *testing.T) {
+		b := hof.NewBag("a", "b", "a")
+		b.AddN("c", 3)
+		b.AddN("d", 0)
+
+		if b.Count("a") != 2 || b.Count("c") != 3 || b.Count("d") != 0 {
+			t.Errorf("unexpected counts: a=%d c=%d d=%d", b.Count("a"), b.Count("c"), b.Count("d"))
+		}
+		if b.Len() != 6 || b.Distinct() != 3 {
+			t.Errorf("Len() = %d, Distinct() = %d, want 6 and 3", b.Len(), b.Distinct())
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		b := hof.NewBag(1, 1, 2)
+
+		if !b.Remove(1) || b.Count(1) != 1 {
+			t.Errorf("Remove(1) should leave one occurrence, got %d", b.Count(1))
+		}
+		if !b.Remove(1) || b.Count(1) != 0 || b.Distinct() != 1 {
+			t.Errorf("Remove(1) should drop the value, got count %d", b.Count(1))
+		}
+		if b.Remove(3) {
+			t.Errorf("Remove(3) on missing value should report false")
+		}
+	})
+
+	t.Run("zero value is usable", func(t *testing.T) {
+		var b hof.Bag[int]
+		b.Add(7)
+
+		if b.Count(7) != 1 {
+			t.Errorf("Count(7) = %d, want 1", b.Count(7))
+		}
+	})
+
+	t.Run("all in insertion order", func(t *testing.T) {
+		b := hof.NewBag("x", "y", "x", "z")
+		var got []hof.Entry[string, int]
+		for v, n := range b.All() {
+			got = append(got, hof.Entry[string, int]{Key: v, Value: n})
+		}
+		want := []hof.Entry[string, int]{{"x", 2}, {"y", 1}, {"z", 1}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
+
+func TestBagMostCommon(t *testing.T) {
+	b := hof.NewBag("b", "a", "c", "a", "c", "a", "d")
+
+	t.Run("top n with ties in insertion order", func(t *testing.T) {
+		got := b.MostCommon(3)
+		want := []hof.Entry[string, int]{{"a", 3}, {"c", 2}, {"b", 1}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("non-positive n returns all", func(t *testing.T) {
+		got := b.MostCommon(0)
+		if len(got) != 4 {
+			t.Errorf("MostCommon(0) returned %d entries, want 4", len(got))
+		}
+	})
+}
+
+func TestBagAlgebra(t *testing.T) {
+	a := hof.NewBag(1, 1, 1, 2, 3)
+	b := hof.NewBag(1, 2, 2, 4)
+
+	collect := func(bag *hof.Bag[int]) map[int]int {
+		out := make(map[int]int)
+		for v, n := range bag.All() {
+			out[v] = n
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		got  *hof.Bag[int]
+		want map[int]int
+	}{
+		{"union", a.Union(b), map[int]int{1: 3, 2: 2, 3: 1, 4: 1}},
+		{"intersection", a.Intersection(b), map[int]int{1: 1, 2: 1}},
+		{"sum", a.Sum(b), map[int]int{1: 4, 2: 3, 3: 1, 4: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := collect(tt.got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+		})
+	}
+}
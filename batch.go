@@ -0,0 +1,141 @@
+package hof
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// Batching
+
+// Clock : Source of time, injectable so batching can be tested without sleeping
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer : Single-shot timer created by a Clock
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+type systemTimer struct{ t *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time { return t.t.C }
+
+func (t systemTimer) Stop() bool { return t.t.Stop() }
+
+// BatchOption : Configure Batch and BatchSeq
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	clock Clock
+}
+
+// WithClock : Measure batch age with c instead of the system clock
+func WithClock(c Clock) BatchOption {
+	return func(cfg *batchConfig) { cfg.clock = c }
+}
+
+func newBatchConfig(opts []BatchOption) batchConfig {
+	cfg := batchConfig{clock: systemClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// Batch : Group a live stream into batches flushed at maxSize items or maxWait after the first item
+//
+// A non-positive maxSize or maxWait disables that limit. The output closes
+// after flushing the final batch when in closes, or immediately when ctx is
+// cancelled, dropping any partial batch.
+func Batch[T any](ctx context.Context, in <-chan T, maxSize int, maxWait time.Duration, opts ...BatchOption) <-chan []T {
+	cfg := newBatchConfig(opts)
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+		var batch []T
+		var timer Timer
+		var timeout <-chan time.Time
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			select {
+			case out <- batch:
+				batch = nil
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && maxWait > 0 {
+					timer = cfg.clock.NewTimer(maxWait)
+					timeout = timer.C()
+				}
+				if maxSize > 0 && len(batch) >= maxSize && !flush() {
+					return
+				}
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// BatchSeq : Group a sequence into batches of maxSize items or spanning at most maxWait
+//
+// A pull-based sequence cannot be interrupted while waiting for upstream, so
+// batch age is checked as each element arrives rather than by a timer.
+func BatchSeq[T any](seq iter.Seq[T], maxSize int, maxWait time.Duration, opts ...BatchOption) iter.Seq[[]T] {
+	cfg := newBatchConfig(opts)
+	return func(yield func([]T) bool) {
+		var batch []T
+		var started time.Time
+		for v := range seq {
+			if len(batch) == 0 {
+				started = cfg.clock.Now()
+			}
+			batch = append(batch, v)
+			full := maxSize > 0 && len(batch) >= maxSize
+			expired := maxWait > 0 && cfg.clock.Now().Sub(started) >= maxWait
+			if full || expired {
+				if !yield(batch) {
+					return
+				}
+				batch = nil
+			}
+		}
+		if len(batch) > 0 {
+			yield(batch)
+		}
+	}
+}
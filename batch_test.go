@@ -0,0 +1,230 @@
+package hof_test
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/suryanshu-09/hof"
+)
+
+// fakeClock only moves when Advance is called.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	created chan struct{}
+}
+
+type fakeTimer struct {
+	clock   *fakeClock
+	c       chan time.Time
+	at      time.Time
+	stopped bool
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0), created: make(chan struct{}, 16)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) hof.Timer {
+	c.mu.Lock()
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1), at: c.now.Add(d)}
+	c.timers = append(c.timers, t)
+	c.mu.Unlock()
+	c.created <- struct{}{}
+	return t
+}
+
+// Advance moves time forward and fires every due timer.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	var pending []*fakeTimer
+	for _, t := range c.timers {
+		switch {
+		case t.stopped:
+		case !t.at.After(c.now):
+			t.c <- c.now
+		default:
+			pending = append(pending, t)
+		}
+	}
+	c.timers = pending
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasActive := !t.stopped
+	t.stopped = true
+	return wasActive
+}
+
+func receiveBatch[T any](t *testing.T, out <-chan []T) []T {
+	t.Helper()
+	select {
+	case b, ok := <-out:
+		if !ok {
+			t.Fatal("output closed unexpectedly")
+		}
+		return b
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a batch")
+		return nil
+	}
+}
+
+func TestBatch(t *testing.T) {
+	t.Run("flush on size", func(t *testing.T) {
+		clock := newFakeClock()
+		in := make(chan int)
+		out := hof.Batch(context.Background(), in, 3, time.Minute, hof.WithClock(clock))
+
+		go func() {
+			for i := range 7 {
+				in <- i
+			}
+			close(in)
+		}()
+
+		got := [][]int{receiveBatch(t, out), receiveBatch(t, out), receiveBatch(t, out)}
+		want := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+		if _, ok := <-out; ok {
+			t.Errorf("output should close after input closes")
+		}
+	})
+
+	t.Run("flush on wait", func(t *testing.T) {
+		clock := newFakeClock()
+		in := make(chan string)
+		out := hof.Batch(context.Background(), in, 10, time.Second, hof.WithClock(clock))
+
+		in <- "a"
+		<-clock.created
+		in <- "b"
+		clock.Advance(999 * time.Millisecond)
+		select {
+		case b := <-out:
+			t.Fatalf("batch %v flushed before maxWait", b)
+		default:
+		}
+		clock.Advance(time.Millisecond)
+
+		if got := receiveBatch(t, out); !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("got:%v", got)
+		}
+
+		in <- "c"
+		<-clock.created
+		clock.Advance(time.Second)
+		if got := receiveBatch(t, out); !reflect.DeepEqual(got, []string{"c"}) {
+			t.Errorf("got:%v", got)
+		}
+		close(in)
+	})
+
+	t.Run("size flush stops timer", func(t *testing.T) {
+		clock := newFakeClock()
+		in := make(chan int)
+		out := hof.Batch(context.Background(), in, 2, time.Second, hof.WithClock(clock))
+
+		in <- 1
+		<-clock.created
+		in <- 2
+		if got := receiveBatch(t, out); !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Errorf("got:%v", got)
+		}
+
+		clock.Advance(time.Hour)
+		close(in)
+		if b, ok := <-out; ok {
+			t.Errorf("unexpected batch %v after stopped timer", b)
+		}
+	})
+
+	t.Run("cancel closes output", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := hof.Batch(ctx, in, 10, 0)
+
+		in <- 1
+		cancel()
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Errorf("expected output to close without a batch")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("output did not close after cancel")
+		}
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		in := make(chan int, 1)
+		out := hof.Batch(context.Background(), in, 0, 10*time.Millisecond)
+		in <- 42
+
+		if got := receiveBatch(t, out); !reflect.DeepEqual(got, []int{42}) {
+			t.Errorf("got:%v", got)
+		}
+		close(in)
+	})
+}
+
+func TestBatchSeq(t *testing.T) {
+	t.Run("size only", func(t *testing.T) {
+		got := slices.Collect(hof.BatchSeq(slices.Values([]int{1, 2, 3, 4, 5}), 2, 0))
+		want := [][]int{{1, 2}, {3, 4}, {5}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("age checked on arrival", func(t *testing.T) {
+		clock := newFakeClock()
+		arrivals := []time.Duration{0, 2, 2, 10, 1, 1}
+		var source iter.Seq[int] = func(yield func(int) bool) {
+			for i, d := range arrivals {
+				clock.Advance(d * time.Second)
+				if !yield(i) {
+					return
+				}
+			}
+		}
+
+		// Element 3 arrives 14s after the batch started, so it closes that batch.
+		got := slices.Collect(hof.BatchSeq(source, 100, 5*time.Second, hof.WithClock(clock)))
+		want := [][]int{{0, 1, 2, 3}, {4, 5}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		pulled := 0
+		for range hof.BatchSeq(countingSeq(100, &pulled), 3, 0) {
+			break
+		}
+		if pulled != 3 {
+			t.Errorf("pulled %d elements, want 3", pulled)
+		}
+	})
+}
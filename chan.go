@@ -0,0 +1,73 @@
+package hof
+
+import (
+	"context"
+	"iter"
+)
+
+// Channel Adapters
+
+// Result : Value or error carried over a channel
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// FromChan : Iterate over values received from ch until it closes
+//
+// Breaking out early leaves the remaining values in ch.
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToChan : Send seq's values on a channel from a producer goroutine
+//
+// The channel closes when seq is exhausted. Cancelling ctx stops the producer
+// and closes the channel, so a consumer that stops reading early must cancel.
+func ToChan[T any](ctx context.Context, seq iter.Seq[T], buffer int) <-chan T {
+	out := make(chan T, max(buffer, 0))
+	go func() {
+		defer close(out)
+		for v := range seq {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FromChan2 : Iterate over values and errors received from ch until it closes
+func FromChan2[T any](ch <-chan Result[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range ch {
+			if !yield(r.Value, r.Err) {
+				return
+			}
+		}
+	}
+}
+
+// ToChan2 : Send seq's values and errors on a channel from a producer goroutine
+func ToChan2[T any](ctx context.Context, seq iter.Seq2[T, error], buffer int) <-chan Result[T] {
+	out := make(chan Result[T], max(buffer, 0))
+	go func() {
+		defer close(out)
+		for v, err := range seq {
+			select {
+			case out <- Result[T]{Value: v, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
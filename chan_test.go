@@ -0,0 +1,175 @@
+package hof_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/synctest"
+
+	"github.com/suryanshu-09/hof"
+)
+
+// Goroutine-leak tests run inside a synctest bubble, which fails the test if
+// any goroutine it started is still blocked when the test function returns.
+
+func TestFromChan(t *testing.T) {
+	t.Run("drains until close", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		got := slices.Collect(hof.FromChan(ch))
+		if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("early break leaves values", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		for range hof.FromChan(ch) {
+			break
+		}
+		if got := slices.Collect(hof.FromChan(ch)); !reflect.DeepEqual(got, []int{2, 3}) {
+			t.Errorf("remaining values = %v, want [2 3]", got)
+		}
+	})
+
+	t.Run("composes with adapters", func(t *testing.T) {
+		ch := make(chan int, 5)
+		for i := range 5 {
+			ch <- i
+		}
+		close(ch)
+
+		got := slices.Collect(hof.Take(hof.FromChan(ch), 2))
+		if !reflect.DeepEqual(got, []int{0, 1}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			out := hof.ToChan(context.Background(), hof.Range(0, 5, 1), 2)
+			got := slices.Collect(hof.FromChan(out))
+
+			if !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+				t.Errorf("got:%v", got)
+			}
+		})
+	})
+
+	t.Run("cancel stops infinite producer", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			pulled := 0
+			source := func(yield func(int) bool) {
+				for i := 0; ; i++ {
+					pulled++
+					if !yield(i) {
+						return
+					}
+				}
+			}
+
+			out := hof.ToChan(ctx, source, 0)
+			if v := <-out; v != 0 {
+				t.Errorf("first value = %d, want 0", v)
+			}
+			cancel()
+			synctest.Wait()
+
+			if _, ok := <-out; ok {
+				t.Errorf("output should be closed after cancel")
+			}
+			if pulled > 2 {
+				t.Errorf("producer pulled %d values after cancel", pulled)
+			}
+		})
+	})
+
+	t.Run("cancel while consumer is idle", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			out := hof.ToChan(ctx, hof.RepeatForever("x"), 4)
+
+			<-out
+			cancel()
+			// No further reads: the producer must still exit on its own.
+		})
+	})
+}
+
+func TestChan2(t *testing.T) {
+	errBoom := errors.New("boom")
+	source := func(yield func(int, error) bool) {
+		if !yield(1, nil) {
+			return
+		}
+		if !yield(0, errBoom) {
+			return
+		}
+		yield(3, nil)
+	}
+
+	t.Run("round trip with errors", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			var vals []int
+			var errs []error
+			for v, err := range hof.FromChan2(hof.ToChan2(context.Background(), source, 0)) {
+				vals = append(vals, v)
+				errs = append(errs, err)
+			}
+
+			if !reflect.DeepEqual(vals, []int{1, 0, 3}) {
+				t.Errorf("values = %v", vals)
+			}
+			if errs[0] != nil || !errors.Is(errs[1], errBoom) || errs[2] != nil {
+				t.Errorf("errors = %v", errs)
+			}
+		})
+	})
+
+	t.Run("paginate over a channel", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			b := newFakeBackend(5, 2)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var got []int
+			for r := range hof.ToChan2(ctx, hof.Paginate(ctx, b.fetch), 1) {
+				if r.Err != nil {
+					t.Fatalf("unexpected error %v", r.Err)
+				}
+				got = append(got, r.Value)
+			}
+			if !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+				t.Errorf("got:%v", got)
+			}
+		})
+	})
+
+	t.Run("cancel stops producer", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			infinite := func(yield func(int, error) bool) {
+				for i := 0; yield(i, nil); i++ {
+				}
+			}
+
+			out := hof.ToChan2(ctx, infinite, 0)
+			<-out
+			cancel()
+		})
+	})
+}
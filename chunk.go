@@ -0,0 +1,78 @@
+package hof
+
+import "slices"
+
+// Chunk Variants
+//
+// Like Chunk, the view-returning variants cap each chunk's capacity so
+// appending to one never overwrites its neighbour.
+
+// ChunkCopy : Split slice into groups that each own their memory
+func ChunkCopy[T any](arr []T, size int) [][]T {
+	chunks := Chunk(arr, size)
+	for i, c := range chunks {
+		chunks[i] = slices.Clone(c)
+	}
+	return chunks
+}
+
+// ChunkBy : Split slice into runs of consecutive elements sharing a key
+func ChunkBy[T any, K comparable](arr []T, keyFn func(T) K) [][]T {
+	var chunks [][]T
+	start := 0
+	var prev K
+	for i, v := range arr {
+		key := keyFn(v)
+		if i > 0 && key != prev {
+			chunks = append(chunks, arr[start:i:i])
+			start = i
+		}
+		prev = key
+	}
+	if start < len(arr) {
+		chunks = append(chunks, arr[start:len(arr):len(arr)])
+	}
+	return chunks
+}
+
+// SplitWhen : Split slice between consecutive elements where fn(prev, next) is true
+func SplitWhen[T any](arr []T, fn func(prev, next T) bool) [][]T {
+	var chunks [][]T
+	start := 0
+	for i := 1; i < len(arr); i++ {
+		if fn(arr[i-1], arr[i]) {
+			chunks = append(chunks, arr[start:i:i])
+			start = i
+		}
+	}
+	if start < len(arr) {
+		chunks = append(chunks, arr[start:len(arr):len(arr)])
+	}
+	return chunks
+}
+
+// SplitAt : Split slice before index i, clamped to its bounds
+func SplitAt[T any](arr []T, i int) ([]T, []T) {
+	i = min(max(i, 0), len(arr))
+	return arr[:i:i], arr[i:len(arr):len(arr)]
+}
+
+// ChunkBySize : Split slice into batches whose total weight stays within maxWeight
+//
+// An element heavier than maxWeight on its own gets a batch to itself.
+func ChunkBySize[T any](arr []T, weightFn func(T) int, maxWeight int) [][]T {
+	var chunks [][]T
+	start, weight := 0, 0
+	for i, v := range arr {
+		w := weightFn(v)
+		if i > start && weight+w > maxWeight {
+			chunks = append(chunks, arr[start:i:i])
+			start, weight = i, 0
+		}
+		weight += w
+	}
+	if start < len(arr) {
+		chunks = append(chunks, arr[start:len(arr):len(arr)])
+	}
+	return chunks
+}
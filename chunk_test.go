@@ -0,0 +1,168 @@
+package hof_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestChunkAppendDoesNotClobber(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5, 6}
+	chunks := hof.Chunk(arr, 2)
+	chunks[0] = append(chunks[0], 99)
+
+	if !reflect.DeepEqual(chunks[1], []int{3, 4}) {
+		t.Errorf("appending to chunk 0 changed chunk 1: %v", chunks[1])
+	}
+	if !reflect.DeepEqual(arr, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("appending to chunk 0 changed the input: %v", arr)
+	}
+}
+
+func TestChunkVariantsCapEveryChunk(t *testing.T) {
+	// arr has spare capacity, so an uncapped last chunk could append into backing[6:].
+	backing := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	arr := backing[:6]
+	odd := func(n int) bool { return n%2 == 1 }
+	one := func(int) int { return 1 }
+
+	left, right := hof.SplitAt(arr, 2)
+	variants := map[string][][]int{
+		"ChunkBy":     hof.ChunkBy(arr, odd),
+		"SplitWhen":   hof.SplitWhen(arr, func(prev, next int) bool { return next == 4 }),
+		"SplitAt":     {left, right},
+		"ChunkBySize": hof.ChunkBySize(arr, one, 4),
+	}
+	for name, chunks := range variants {
+		for i, c := range chunks {
+			if cap(c) != len(c) {
+				t.Errorf("%s chunk %d has len %d but cap %d", name, i, len(c), cap(c))
+			}
+		}
+		last := chunks[len(chunks)-1]
+		_ = append(last, 99)
+		if backing[6] != 7 {
+			t.Errorf("%s: appending to the last chunk overwrote the input's backing array", name)
+			backing[6] = 7
+		}
+	}
+}
+
+func TestChunkCopy(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	got := hof.ChunkCopy(arr, 2)
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+
+	got[0][0] = 42
+	if arr[0] != 1 {
+		t.Errorf("mutating a copied chunk changed the input: %v", arr)
+	}
+	if hof.ChunkCopy(arr, 0) != nil {
+		t.Errorf("ChunkCopy with size 0 should return nil")
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  [][]int
+	}{
+		{"parity runs", []int{1, 3, 2, 4, 6, 5, 7}, [][]int{{1, 3}, {2, 4, 6}, {5, 7}}},
+		{"single run", []int{2, 4}, [][]int{{2, 4}}},
+		{"empty", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hof.ChunkBy(tt.input, func(n int) bool { return n%2 == 0 })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitWhen(t *testing.T) {
+	t.Run("split on gaps", func(t *testing.T) {
+		input := []int{1, 2, 3, 7, 8, 12}
+		got := hof.SplitWhen(input, func(prev, next int) bool { return next-prev > 1 })
+		want := [][]int{{1, 2, 3}, {7, 8}, {12}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("never splits", func(t *testing.T) {
+		got := hof.SplitWhen([]string{"a", "b"}, func(string, string) bool { return false })
+		if !reflect.DeepEqual(got, [][]string{{"a", "b"}}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if got := hof.SplitWhen([]int{}, func(int, int) bool { return true }); got != nil {
+			t.Errorf("got:%v, want nil", got)
+		}
+	})
+}
+
+func TestSplitAt(t *testing.T) {
+	arr := []int{1, 2, 3, 4}
+
+	tests := []struct {
+		name        string
+		i           int
+		left, right []int
+	}{
+		{"middle", 1, []int{1}, []int{2, 3, 4}},
+		{"start", 0, []int{}, []int{1, 2, 3, 4}},
+		{"end", 4, []int{1, 2, 3, 4}, []int{}},
+		{"clamped high", 10, []int{1, 2, 3, 4}, []int{}},
+		{"clamped low", -3, []int{}, []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := hof.SplitAt(arr, tt.i)
+			if !reflect.DeepEqual(left, tt.left) || !reflect.DeepEqual(right, tt.right) {
+				t.Errorf("got %v, %v want %v, %v", left, right, tt.left, tt.right)
+			}
+		})
+	}
+
+	t.Run("append to left keeps right", func(t *testing.T) {
+		left, right := hof.SplitAt([]int{1, 2, 3}, 1)
+		_ = append(left, 99)
+
+		if right[0] != 2 {
+			t.Errorf("appending to left overwrote right: %v", right)
+		}
+	})
+}
+
+func TestChunkBySize(t *testing.T) {
+	msgs := []string{"aaaa", "bb", "cccc", "d", "eeeeeeeeee", "ff", "g"}
+	size := func(s string) int { return len(s) }
+
+	t.Run("byte budget", func(t *testing.T) {
+		got := hof.ChunkBySize(msgs, size, 6)
+		want := [][]string{{"aaaa", "bb"}, {"cccc", "d"}, {"eeeeeeeeee"}, {"ff", "g"}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if got := hof.ChunkBySize(nil, size, 6); got != nil {
+			t.Errorf("got:%v, want nil", got)
+		}
+	})
+}
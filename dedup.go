@@ -0,0 +1,117 @@
+package hof
+
+import (
+	"iter"
+	"slices"
+)
+
+// Deduplication
+
+// UniqueBy : Remove elements whose key was already seen, keeping the first
+func UniqueBy[T any, K comparable](arr []T, keyFn func(T) K) []T {
+	seen := make(Set[K])
+	var result []T
+	for _, v := range arr {
+		key := keyFn(v)
+		if !seen.Has(key) {
+			seen.Add(key)
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// UniqueByLast : Remove elements whose key appears again later, keeping the last
+func UniqueByLast[T any, K comparable](arr []T, keyFn func(T) K) []T {
+	seen := make(Set[K])
+	var result []T
+	for i := len(arr) - 1; i >= 0; i-- {
+		key := keyFn(arr[i])
+		if !seen.Has(key) {
+			seen.Add(key)
+			result = append(result, arr[i])
+		}
+	}
+	slices.Reverse(result)
+	return result
+}
+
+// DedupConsecutive : Collapse runs of equal elements, like Unix uniq
+func DedupConsecutive[T comparable](arr []T) []T {
+	return DedupConsecutiveBy(arr, func(v T) T { return v })
+}
+
+// DedupConsecutiveBy : Collapse runs of elements sharing a key, keeping the first of each run
+func DedupConsecutiveBy[T any, K comparable](arr []T, keyFn func(T) K) []T {
+	var result []T
+	var prev K
+	for i, v := range arr {
+		key := keyFn(v)
+		if i == 0 || key != prev {
+			result = append(result, v)
+		}
+		prev = key
+	}
+	return result
+}
+
+// UniqueSeq : Lazily drop elements already seen
+func UniqueSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return UniqueBySeq(seq, func(v T) T { return v })
+}
+
+// UniqueBySeq : Lazily drop elements whose key was already seen
+func UniqueBySeq[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(Set[K])
+		for v := range seq {
+			key := keyFn(v)
+			if seen.Has(key) {
+				continue
+			}
+			seen.Add(key)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// UniqueByLastSeq : Keep the last element per key; buffers the whole input before yielding
+func UniqueByLastSeq[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var buf []T
+		for v := range seq {
+			buf = append(buf, v)
+		}
+		for _, v := range UniqueByLast(buf, keyFn) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DedupConsecutiveSeq : Lazily collapse runs of equal elements
+func DedupConsecutiveSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return DedupConsecutiveBySeq(seq, func(v T) T { return v })
+}
+
+// DedupConsecutiveBySeq : Lazily collapse runs of elements sharing a key
+func DedupConsecutiveBySeq[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var prev K
+		first := true
+		for v := range seq {
+			key := keyFn(v)
+			if !first && key == prev {
+				continue
+			}
+			first = false
+			prev = key
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package hof_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+var users = []user{
+	{1, "ann"},
+	{2, "bob"},
+	{1, "ann v2"},
+	{3, "cid"},
+	{2, "bob v2"},
+}
+
+func userID(u user) int { return u.ID }
+
+func TestUniqueBy(t *testing.T) {
+	t.Run("keeps first per key", func(t *testing.T) {
+		got := hof.UniqueBy(users, userID)
+		want := []user{{1, "ann"}, {2, "bob"}, {3, "cid"}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		got := hof.UniqueBy([]user{}, userID)
+		if len(got) != 0 {
+			t.Errorf("UniqueBy() = %v, want empty", got)
+		}
+	})
+}
+
+func TestUniqueByLast(t *testing.T) {
+	got := hof.UniqueByLast(users, userID)
+	want := []user{{1, "ann v2"}, {3, "cid"}, {2, "bob v2"}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestDedupConsecutive(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{"runs collapse", []int{1, 1, 2, 2, 2, 1, 3, 3}, []int{1, 2, 1, 3}},
+		{"no runs", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"single", []int{0}, []int{0}},
+		{"empty", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hof.DedupConsecutive(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupConsecutiveBy(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "apricot"}
+	got := hof.DedupConsecutiveBy(words, func(s string) byte { return s[0] })
+	want := []string{"apple", "banana", "apricot"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestDedupSeq(t *testing.T) {
+	t.Run("unique seq", func(t *testing.T) {
+		got := slices.Collect(hof.UniqueSeq(slices.Values([]int{3, 1, 3, 2, 1})))
+		want := []int{3, 1, 2}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("unique by seq", func(t *testing.T) {
+		got := slices.Collect(hof.UniqueBySeq(slices.Values(users), userID))
+		want := hof.UniqueBy(users, userID)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("unique by last seq", func(t *testing.T) {
+		got := slices.Collect(hof.UniqueByLastSeq(slices.Values(users), userID))
+		want := hof.UniqueByLast(users, userID)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("dedup consecutive seq", func(t *testing.T) {
+		got := slices.Collect(hof.DedupConsecutiveSeq(slices.Values([]string{"a", "a", "b", "a"})))
+		want := []string{"a", "b", "a"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
+
+func TestUniqueSeqEarlyTermination(t *testing.T) {
+	pulled := 0
+	source := func(yield func(int) bool) {
+		for _, v := range []int{1, 1, 2, 3, 4, 5} {
+			pulled++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	var result []int
+	for v := range hof.UniqueSeq(source) {
+		result = append(result, v)
+		if len(result) >= 2 {
+			break
+		}
+	}
+
+	if !slices.Equal(result, []int{1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2}, result)
+	}
+	if pulled != 3 {
+		t.Errorf("pulled %d elements from upstream, want 3", pulled)
+	}
+}
@@ -0,0 +1,247 @@
+package hof
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Sequence Diff
+
+// EditOp : Kind of step in an edit script
+type EditOp int
+
+const (
+	EditEqual EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	default:
+		return fmt.Sprintf("EditOp(%d)", int(op))
+	}
+}
+
+// Edit : One step of an edit script turning a into b
+//
+// A and B are the positions in a and b at which the step applies; an insert
+// happens before a[A] and a delete removes a[A] before b[B].
+type Edit[T any] struct {
+	Op    EditOp
+	A, B  int
+	Value T
+}
+
+// Diff : Shortest edit script turning a into b
+func Diff[T comparable](a, b []T) []Edit[T] {
+	return DiffFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// DiffFunc : Shortest edit script turning a into b, comparing elements with eq
+//
+// Uses the linear-space variant of Myers' O(ND) algorithm, where D is the size
+// of the script, so memory stays O(N+M) however different the inputs are.
+// Within each run of changes, deletes come before inserts.
+func DiffFunc[T any](a, b []T, eq func(x, y T) bool) []Edit[T] {
+	size := (len(a)+len(b))/2 + 2
+	d := &differ[T]{a: a, b: b, eq: eq, offset: size, vf: make([]int, 2*size+1), vb: make([]int, 2*size+1)}
+	d.diff(0, len(a), 0, len(b))
+	return groupChanges(d.edits)
+}
+
+// differ holds the inputs and the two frontier arrays shared by every level
+// of the divide-and-conquer recursion.
+type differ[T any] struct {
+	a, b   []T
+	eq     func(x, y T) bool
+	offset int
+	vf, vb []int
+	edits  []Edit[T]
+}
+
+func (d *differ[T]) equal(aLo, aHi, bLo int) {
+	for x := aLo; x < aHi; x++ {
+		d.edits = append(d.edits, Edit[T]{Op: EditEqual, A: x, B: bLo + x - aLo, Value: d.a[x]})
+	}
+}
+
+// diff appends the edits turning a[aLo:aHi] into b[bLo:bHi].
+func (d *differ[T]) diff(aLo, aHi, bLo, bHi int) {
+	start := aLo
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aLo], d.b[bLo]) {
+		aLo++
+		bLo++
+	}
+	d.equal(start, aLo, bLo-(aLo-start))
+
+	end := aHi
+	for aHi > aLo && bHi > bLo && d.eq(d.a[aHi-1], d.b[bHi-1]) {
+		aHi--
+		bHi--
+	}
+
+	switch {
+	case aLo == aHi:
+		for y := bLo; y < bHi; y++ {
+			d.edits = append(d.edits, Edit[T]{Op: EditInsert, A: aLo, B: y, Value: d.b[y]})
+		}
+	case bLo == bHi:
+		for x := aLo; x < aHi; x++ {
+			d.edits = append(d.edits, Edit[T]{Op: EditDelete, A: x, B: bLo, Value: d.a[x]})
+		}
+	default:
+		// With the common ends trimmed both halves hold at least one change,
+		// so each recursion strictly shrinks the edit distance.
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.diff(aLo, x, bLo, y)
+		d.equal(x, u, y)
+		d.diff(u, aHi, v, bHi)
+	}
+
+	d.equal(aHi, end, bHi)
+}
+
+// middleSnake finds the diagonal run (x, y) to (u, v) at the middle of a
+// shortest edit path by searching forward from the start and backward from
+// the end until the two frontiers overlap.
+func (d *differ[T]) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	vf, vb, off := d.vf, d.vb, d.offset
+	vf[off+1], vb[off+1] = 0, 0
+
+	for depth := 0; depth <= (n+m+1)/2; depth++ {
+		for k := -depth; k <= depth; k += 2 {
+			var fx int
+			if k == -depth || (k != depth && vf[off+k-1] < vf[off+k+1]) {
+				fx = vf[off+k+1]
+			} else {
+				fx = vf[off+k-1] + 1
+			}
+			fy := fx - k
+			sx, sy := fx, fy
+			for fx < n && fy < m && d.eq(d.a[aLo+fx], d.b[bLo+fy]) {
+				fx++
+				fy++
+			}
+			vf[off+k] = fx
+			if rk := delta - k; odd && rk >= -(depth-1) && rk <= depth-1 && fx+vb[off+rk] >= n {
+				return aLo + sx, bLo + sy, aLo + fx, bLo + fy
+			}
+		}
+		for k := -depth; k <= depth; k += 2 {
+			var rx int
+			if k == -depth || (k != depth && vb[off+k-1] < vb[off+k+1]) {
+				rx = vb[off+k+1]
+			} else {
+				rx = vb[off+k-1] + 1
+			}
+			ry := rx - k
+			sx, sy := rx, ry
+			for rx < n && ry < m && d.eq(d.a[aHi-1-rx], d.b[bHi-1-ry]) {
+				rx++
+				ry++
+			}
+			vb[off+k] = rx
+			if fk := delta - k; !odd && fk >= -depth && fk <= depth && rx+vf[off+fk] >= n {
+				return aHi - rx, bHi - ry, aHi - sx, bHi - sy
+			}
+		}
+	}
+	panic("hof: diff frontiers never met")
+}
+
+// groupChanges reorders each run of consecutive changes so its deletes come
+// before its inserts, renumbering positions to match.
+func groupChanges[T any](edits []Edit[T]) []Edit[T] {
+	for i := 0; i < len(edits); {
+		if edits[i].Op == EditEqual {
+			i++
+			continue
+		}
+		j := i
+		for j < len(edits) && edits[j].Op != EditEqual {
+			j++
+		}
+		x, y := edits[i].A, edits[i].B
+		run := slices.Clone(edits[i:j])
+		slices.SortStableFunc(run, func(p, q Edit[T]) int { return int(p.Op) - int(q.Op) })
+		for n, e := range run {
+			e.A, e.B = x, y
+			if e.Op == EditDelete {
+				x++
+			} else {
+				y++
+			}
+			edits[i+n] = e
+		}
+		i = j
+	}
+	return edits
+}
+
+// UnifiedDiff : Render an edit script as unified-diff hunks with context lines
+func UnifiedDiff[T any](edits []Edit[T], context int) string {
+	context = max(context, 0)
+	var sb strings.Builder
+	for i := 0; i < len(edits); {
+		if edits[i].Op == EditEqual {
+			i++
+			continue
+		}
+
+		lo := max(i-context, 0)
+		hi := i
+		for j := i; j < len(edits); j++ {
+			if edits[j].Op != EditEqual {
+				hi = j
+			} else if j-hi > 2*context {
+				break
+			}
+		}
+		hi = min(hi+context+1, len(edits))
+		writeHunk(&sb, edits[lo:hi])
+		i = hi
+	}
+	return sb.String()
+}
+
+func writeHunk[T any](sb *strings.Builder, hunk []Edit[T]) {
+	aLen, bLen := 0, 0
+	for _, e := range hunk {
+		if e.Op != EditInsert {
+			aLen++
+		}
+		if e.Op != EditDelete {
+			bLen++
+		}
+	}
+	aStart, bStart := hunk[0].A, hunk[0].B
+	if aLen > 0 {
+		aStart++
+	}
+	if bLen > 0 {
+		bStart++
+	}
+
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+	for _, e := range hunk {
+		prefix := " "
+		switch e.Op {
+		case EditDelete:
+			prefix = "-"
+		case EditInsert:
+			prefix = "+"
+		}
+		fmt.Fprintf(sb, "%s%v\n", prefix, e.Value)
+	}
+}
@@ -0,0 +1,200 @@
+package hof_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/suryanshu-09/hof"
+)
+
+// applyEdits rebuilds both inputs from an edit script.
+func applyEdits[T any](edits []hof.Edit[T]) (a, b []T) {
+	for _, e := range edits {
+		if e.Op != hof.EditInsert {
+			a = append(a, e.Value)
+		}
+		if e.Op != hof.EditDelete {
+			b = append(b, e.Value)
+		}
+	}
+	return a, b
+}
+
+func countChanges[T any](edits []hof.Edit[T]) int {
+	n := 0
+	for _, e := range edits {
+		if e.Op != hof.EditEqual {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    []string
+		changes int
+	}{
+		{"classic", strings.Split("ABCABBA", ""), strings.Split("CBABAC", ""), 5},
+		{"identical", []string{"x", "y"}, []string{"x", "y"}, 0},
+		{"all inserted", nil, []string{"x", "y"}, 2},
+		{"all deleted", []string{"x", "y"}, nil, 2},
+		{"both empty", nil, nil, 0},
+		{"replace middle", []string{"a", "b", "c"}, []string{"a", "x", "c"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edits := hof.Diff(tt.a, tt.b)
+			gotA, gotB := applyEdits(edits)
+
+			if len(gotA) != len(tt.a) || (len(tt.a) > 0 && !reflect.DeepEqual(gotA, tt.a)) {
+				t.Errorf("edits do not reproduce a: got %v, want %v", gotA, tt.a)
+			}
+			if len(gotB) != len(tt.b) || (len(tt.b) > 0 && !reflect.DeepEqual(gotB, tt.b)) {
+				t.Errorf("edits do not reproduce b: got %v, want %v", gotB, tt.b)
+			}
+			if got := countChanges(edits); got != tt.changes {
+				t.Errorf("edit distance = %d, want %d", got, tt.changes)
+			}
+		})
+	}
+}
+
+// lcsLen is the textbook O(NM) longest common subsequence length.
+func lcsLen(a, b []byte) int {
+	prev := make([]int, len(b)+1)
+	for i := range a {
+		cur := make([]int, len(b)+1)
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func TestDiffIsShortest(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	random := func() []byte {
+		s := make([]byte, rng.IntN(20))
+		for i := range s {
+			s[i] = "abc"[rng.IntN(3)]
+		}
+		return s
+	}
+
+	for range 500 {
+		a, b := random(), random()
+		edits := hof.Diff(a, b)
+		gotA, gotB := applyEdits(edits)
+
+		if string(gotA) != string(a) || string(gotB) != string(b) {
+			t.Fatalf("Diff(%q, %q) does not reproduce its inputs: %q, %q", a, b, gotA, gotB)
+		}
+		if got, want := countChanges(edits), len(a)+len(b)-2*lcsLen(a, b); got != want {
+			t.Fatalf("Diff(%q, %q) has %d changes, want %d", a, b, got, want)
+		}
+		for i, e := range edits {
+			if e.Op != hof.EditInsert && a[e.A] != e.Value || e.Op != hof.EditDelete && b[e.B] != e.Value {
+				t.Fatalf("Diff(%q, %q) edit %d has wrong position: %+v", a, b, i, e)
+			}
+		}
+	}
+}
+
+func TestDiffMemory(t *testing.T) {
+	// Two unrelated inputs give the largest edit distance, D = N+M.
+	const n = 3000
+	a := make([]int, n)
+	b := make([]int, n)
+	for i := range n {
+		a[i], b[i] = i, n+i
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := hof.Diff(a, b)
+	runtime.ReadMemStats(&after)
+
+	if got := countChanges(edits); got != 2*n {
+		t.Fatalf("edit distance = %d, want %d", got, 2*n)
+	}
+	// The script itself holds 2n edits; allow a small multiple of that.
+	script := uint64(2*n) * uint64(unsafe.Sizeof(edits[0]))
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 8*script {
+		t.Errorf("Diff allocated %d bytes for a %d-byte script", allocated, script)
+	}
+}
+
+func TestDiffPositions(t *testing.T) {
+	got := hof.Diff([]int{1, 2, 3}, []int{1, 4, 3})
+	want := []hof.Edit[int]{
+		{Op: hof.EditEqual, A: 0, B: 0, Value: 1},
+		{Op: hof.EditDelete, A: 1, B: 1, Value: 2},
+		{Op: hof.EditInsert, A: 2, B: 1, Value: 4},
+		{Op: hof.EditEqual, A: 2, B: 2, Value: 3},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%+v\nwant:%+v", got, want)
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	a := []string{"Go", "Rust", "Zig"}
+	b := []string{"go", "ZIG"}
+	edits := hof.DiffFunc(a, b, strings.EqualFold)
+
+	if got := countChanges(edits); got != 1 {
+		t.Errorf("edit distance = %d, want 1", got)
+	}
+	if edits[1].Op != hof.EditDelete || edits[1].Value != "Rust" {
+		t.Errorf("edits[1] = %+v, want delete of Rust", edits[1])
+	}
+}
+
+func TestEditOpString(t *testing.T) {
+	if hof.EditInsert.String() != "insert" || hof.EditOp(9).String() != "EditOp(9)" {
+		t.Errorf("unexpected EditOp strings: %v, %v", hof.EditInsert, hof.EditOp(9))
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Run("single hunk", func(t *testing.T) {
+		a := []string{"a", "b", "c", "d", "e"}
+		b := []string{"a", "b", "x", "d", "e"}
+		got := hof.UnifiedDiff(hof.Diff(a, b), 1)
+		want := "@@ -2,3 +2,3 @@\n b\n-c\n+x\n d\n"
+
+		if got != want {
+			t.Errorf("got:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("separate hunks", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		b := []int{0, 1, 2, 3, 4, 5, 6, 7}
+		got := hof.UnifiedDiff(hof.Diff(a, b), 1)
+		want := "@@ -1,1 +1,2 @@\n+0\n 1\n@@ -7,2 +8,1 @@\n 7\n-8\n"
+
+		if got != want {
+			t.Errorf("got:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		if got := hof.UnifiedDiff(hof.Diff([]int{1}, []int{1}), 3); got != "" {
+			t.Errorf("got %q, want empty", got)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package hof
+
+import "iter"
+
+// Sequence Generators
+
+// isFloat reports whether E is a floating-point type.
+func isFloat[E Number]() bool {
+	one := E(1)
+	return one/2 != 0
+}
+
+// Range : Numbers from start towards end (exclusive) by step; a zero step yields nothing
+//
+// Float ranges compute start + i*step so rounding error does not accumulate,
+// and integer ranges stop rather than wrap around on overflow.
+func Range[E Number](start, end, step E) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		var zero E
+		if step == zero {
+			return
+		}
+		inRange := func(v E) bool {
+			if step > zero {
+				return v < end
+			}
+			return v > end
+		}
+
+		if isFloat[E]() {
+			// An int counter keeps counting past 2^24, where a float32 one stalls.
+			for i := 0; ; i++ {
+				v := start + E(i)*step
+				if !inRange(v) || !yield(v) {
+					return
+				}
+			}
+		}
+
+		for v := start; inRange(v); {
+			if !yield(v) {
+				return
+			}
+			next := v + step
+			if (step > zero) != (next > v) {
+				return
+			}
+			v = next
+		}
+	}
+}
+
+// Repeat : Yield v n times; like Take, n <= 0 yields nothing
+func Repeat[T any](v T, n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for range n {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// RepeatForever : Yield v endlessly
+func RepeatForever[T any](v T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for yield(v) {
+		}
+	}
+}
+
+// Iterate : Infinite sequence seed, fn(seed), fn(fn(seed)), ...
+func Iterate[T any](seed T, fn func(T) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := seed; ; v = fn(v) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Cycle : Repeat seq forever, buffering its first pass so one-shot sources work
+func Cycle[T any](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var buf []T
+		for v := range seq {
+			buf = append(buf, v)
+			if !yield(v) {
+				return
+			}
+		}
+		if len(buf) == 0 {
+			return
+		}
+		for {
+			for _, v := range buf {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Unfold : Generate values from a state until fn reports false
+func Unfold[S, T any](seed S, fn func(S) (T, S, bool)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		state := seed
+		for {
+			v, next, ok := fn(state)
+			if !ok || !yield(v) {
+				return
+			}
+			state = next
+		}
+	}
+}
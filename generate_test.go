@@ -0,0 +1,173 @@
+package hof_test
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			start, end, step int
+			want             []int
+		}{
+			{"ascending", 0, 5, 1, []int{0, 1, 2, 3, 4}},
+			{"step two", 1, 8, 2, []int{1, 3, 5, 7}},
+			{"descending", 5, 0, -2, []int{5, 3, 1}},
+			{"empty", 3, 3, 1, nil},
+			{"wrong direction", 0, 5, -1, nil},
+			{"zero step", 0, 5, 0, nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got := slices.Collect(hof.Range(tt.start, tt.end, tt.step))
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("got:%v\nwant:%v", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("floats do not drift", func(t *testing.T) {
+		got := slices.Collect(hof.Range(0, 1, 0.1))
+		if len(got) != 10 {
+			t.Fatalf("Range(0, 1, 0.1) yielded %d values, want 10: %v", len(got), got)
+		}
+		if math.Abs(got[9]-0.9) > 1e-9 {
+			t.Errorf("last value = %v, want 0.9", got[9])
+		}
+	})
+
+	t.Run("large float32 range terminates", func(t *testing.T) {
+		n := 0
+		var last float32
+		for v := range hof.Range[float32](0, 2e7, 1) {
+			n++
+			last = v
+		}
+		if n < 1<<24 || last >= 2e7 {
+			t.Errorf("yielded %d values ending at %v", n, last)
+		}
+	})
+
+	t.Run("negative float step", func(t *testing.T) {
+		got := slices.Collect(hof.Range(1.0, 0, -0.25))
+		want := []float64{1, 0.75, 0.5, 0.25}
+		if !slicesAlmostEqual(got, want, 1e-9) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("stops on overflow", func(t *testing.T) {
+		got := slices.Collect(hof.Range[int8](100, 127, 20))
+		want := []int8{100, 120}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+
+		got = slices.Collect(hof.Range[int8](-120, math.MinInt8, -20))
+		want = []int8{-120}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		got := slices.Collect(hof.Range[uint](250, 256, 3))
+		want := []uint{250, 253}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	if got := slices.Collect(hof.Repeat("x", 3)); !reflect.DeepEqual(got, []string{"x", "x", "x"}) {
+		t.Errorf("Repeat(x, 3) = %v", got)
+	}
+	if got := slices.Collect(hof.Repeat(1, 0)); got != nil {
+		t.Errorf("Repeat(1, 0) = %v, want empty", got)
+	}
+	if got := slices.Collect(hof.Repeat(7, -1)); got != nil {
+		t.Errorf("Repeat(7, -1) = %v, want empty", got)
+	}
+	if got := slices.Collect(hof.Take(hof.RepeatForever(7), 4)); !reflect.DeepEqual(got, []int{7, 7, 7, 7}) {
+		t.Errorf("RepeatForever(7) = %v", got)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	got := slices.Collect(hof.Take(hof.Iterate(1, func(n int) int { return n * 2 }), 5))
+	want := []int{1, 2, 4, 8, 16}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	t.Run("repeats", func(t *testing.T) {
+		got := slices.Collect(hof.Take(hof.Cycle(slices.Values([]int{1, 2, 3})), 7))
+		want := []int{1, 2, 3, 1, 2, 3, 1}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("one-shot source", func(t *testing.T) {
+		ch := make(chan string, 2)
+		ch <- "a"
+		ch <- "b"
+		close(ch)
+		source := func(yield func(string) bool) {
+			for v := range ch {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+
+		got := slices.Collect(hof.Take(hof.Cycle(source), 5))
+		want := []string{"a", "b", "a", "b", "a"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("empty source ends", func(t *testing.T) {
+		if got := slices.Collect(hof.Cycle(slices.Values([]int{}))); got != nil {
+			t.Errorf("Cycle(empty) = %v, want empty", got)
+		}
+	})
+}
+
+func TestUnfold(t *testing.T) {
+	t.Run("fibonacci below 50", func(t *testing.T) {
+		got := slices.Collect(hof.Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+			return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 50
+		}))
+		want := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("digits of a number", func(t *testing.T) {
+		got := slices.Collect(hof.Unfold(1234, func(n int) (int, int, bool) {
+			return n % 10, n / 10, n > 0
+		}))
+		want := []int{4, 3, 2, 1}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package hof
+
+import (
+	"bytes"
+	"hash/maphash"
+	"iter"
+	"slices"
+)
+
+// Hashing Non-Comparable Types
+
+// Hasher : Hash and equality for types that cannot be map keys
+//
+// Values that are Equal must produce the same Hash.
+type Hasher[T any] interface {
+	Hash(T) uint64
+	Equal(a, b T) bool
+}
+
+var hashSeed = maphash.MakeSeed()
+
+type funcHasher[T any] struct {
+	hash  func(T) uint64
+	equal func(a, b T) bool
+}
+
+func (h funcHasher[T]) Hash(v T) uint64   { return h.hash(v) }
+func (h funcHasher[T]) Equal(a, b T) bool { return h.equal(a, b) }
+
+// NewHasher : Build a Hasher from a hash and an equality function
+func NewHasher[T any](hash func(T) uint64, equal func(a, b T) bool) Hasher[T] {
+	return funcHasher[T]{hash: hash, equal: equal}
+}
+
+// BytesHasher : Hasher for byte slices by content
+func BytesHasher() Hasher[[]byte] {
+	return NewHasher(
+		func(b []byte) uint64 { return maphash.Bytes(hashSeed, b) },
+		bytes.Equal,
+	)
+}
+
+// StringsHasher : Hasher for string slices by content and order
+func StringsHasher() Hasher[[]string] {
+	return NewHasher(
+		func(ss []string) uint64 {
+			var h maphash.Hash
+			h.SetSeed(hashSeed)
+			for _, s := range ss {
+				maphash.WriteComparable(&h, len(s))
+				h.WriteString(s)
+			}
+			return h.Sum64()
+		},
+		slices.Equal[[]string],
+	)
+}
+
+// KeyHasher : Hasher comparing values by a comparable key
+func KeyHasher[T any, K comparable](keyFn func(T) K) Hasher[T] {
+	return NewHasher(
+		func(v T) uint64 { return maphash.Comparable(hashSeed, keyFn(v)) },
+		func(a, b T) bool { return keyFn(a) == keyFn(b) },
+	)
+}
+
+// HashSet : Set of values identified by a Hasher
+type HashSet[T any] struct {
+	h       Hasher[T]
+	buckets map[uint64][]T
+	n       int
+}
+
+// NewHashSet : Build a hash set from the given values
+func NewHashSet[T any](h Hasher[T], vals ...T) *HashSet[T] {
+	s := &HashSet[T]{h: h, buckets: make(map[uint64][]T)}
+	for _, v := range vals {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add : Insert v, reporting whether it was not already present
+func (s *HashSet[T]) Add(v T) bool {
+	key := s.h.Hash(v)
+	bucket := s.buckets[key]
+	for _, x := range bucket {
+		if s.h.Equal(x, v) {
+			return false
+		}
+	}
+	s.buckets[key] = append(bucket, v)
+	s.n++
+	return true
+}
+
+// Has : Report whether v is in the set
+func (s *HashSet[T]) Has(v T) bool {
+	for _, x := range s.buckets[s.h.Hash(v)] {
+		if s.h.Equal(x, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove : Delete v, reporting whether it was present
+func (s *HashSet[T]) Remove(v T) bool {
+	key := s.h.Hash(v)
+	bucket := s.buckets[key]
+	for i, x := range bucket {
+		if s.h.Equal(x, v) {
+			if len(bucket) == 1 {
+				delete(s.buckets, key)
+			} else {
+				s.buckets[key] = slices.Delete(bucket, i, i+1)
+			}
+			s.n--
+			return true
+		}
+	}
+	return false
+}
+
+// Len : Number of values in the set
+func (s *HashSet[T]) Len() int {
+	return s.n
+}
+
+// All : Iterate over the values in unspecified order
+func (s *HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, bucket := range s.buckets {
+			for _, v := range bucket {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Group : Key and the elements sharing it
+type Group[K, T any] struct {
+	Key   K
+	Items []T
+}
+
+// UniqueWith : Remove duplicates as judged by h, keeping the first
+func UniqueWith[T any](arr []T, h Hasher[T]) []T {
+	seen := NewHashSet(h)
+	var result []T
+	for _, v := range arr {
+		if seen.Add(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// GroupByWith : Cluster elements by a key compared with h, in first-seen key order
+func GroupByWith[T, K any](arr []T, keyFn func(T) K, h Hasher[K]) []Group[K, T] {
+	var groups []Group[K, T]
+	index := make(map[uint64][]int)
+	for _, v := range arr {
+		key := keyFn(v)
+		hash := h.Hash(key)
+		pos := -1
+		for _, i := range index[hash] {
+			if h.Equal(groups[i].Key, key) {
+				pos = i
+				break
+			}
+		}
+		if pos < 0 {
+			pos = len(groups)
+			groups = append(groups, Group[K, T]{Key: key})
+			index[hash] = append(index[hash], pos)
+		}
+		groups[pos].Items = append(groups[pos].Items, v)
+	}
+	return groups
+}
@@ -0,0 +1,140 @@
+package hof_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestHashers(t *testing.T) {
+	t.Run("bytes hasher", func(t *testing.T) {
+		h := hof.BytesHasher()
+		a, b := []byte("hello"), []byte("hello")
+
+		if h.Hash(a) != h.Hash(b) || !h.Equal(a, b) {
+			t.Errorf("equal byte slices should hash and compare equal")
+		}
+		if h.Equal(a, []byte("world")) {
+			t.Errorf("different byte slices should not be equal")
+		}
+	})
+
+	t.Run("strings hasher respects boundaries", func(t *testing.T) {
+		h := hof.StringsHasher()
+		a := []string{"ab", "c"}
+		b := []string{"a", "bc"}
+
+		if h.Equal(a, b) {
+			t.Errorf("%v and %v should not be equal", a, b)
+		}
+		if h.Hash(a) == h.Hash(b) {
+			t.Errorf("%v and %v should not collide", a, b)
+		}
+		if h.Hash(a) != h.Hash([]string{"ab", "c"}) {
+			t.Errorf("equal slices should hash equal")
+		}
+	})
+
+	t.Run("key hasher", func(t *testing.T) {
+		type doc struct {
+			ID   int
+			Tags []string
+		}
+		h := hof.KeyHasher(func(d doc) int { return d.ID })
+		a := doc{1, []string{"x"}}
+		b := doc{1, []string{"y", "z"}}
+
+		if h.Hash(a) != h.Hash(b) || !h.Equal(a, b) {
+			t.Errorf("values with the same key should be equal")
+		}
+	})
+
+	t.Run("custom hasher", func(t *testing.T) {
+		h := hof.NewHasher(
+			func(s string) uint64 { return uint64(len(s)) },
+			strings.EqualFold,
+		)
+
+		if !h.Equal("Go", "gO") || h.Hash("Go") != 2 {
+			t.Errorf("custom hasher did not use supplied functions")
+		}
+	})
+}
+
+func TestHashSet(t *testing.T) {
+	t.Run("add has remove", func(t *testing.T) {
+		s := hof.NewHashSet(hof.BytesHasher(), []byte("a"), []byte("b"))
+
+		if s.Add([]byte("a")) {
+			t.Errorf("Add() of existing value should report false")
+		}
+		if !s.Add([]byte("c")) {
+			t.Errorf("Add() of new value should report true")
+		}
+		if !s.Has([]byte("b")) || s.Has([]byte("z")) {
+			t.Errorf("Has() mismatch")
+		}
+		if !s.Remove([]byte("a")) || s.Remove([]byte("a")) {
+			t.Errorf("Remove() should succeed once")
+		}
+		if s.Len() != 2 {
+			t.Errorf("Len() = %d, want 2", s.Len())
+		}
+	})
+
+	t.Run("colliding hashes", func(t *testing.T) {
+		h := hof.NewHasher(
+			func(s string) uint64 { return 0 },
+			func(a, b string) bool { return a == b },
+		)
+		s := hof.NewHashSet(h, "x", "y", "z", "y")
+
+		if s.Len() != 3 {
+			t.Errorf("Len() = %d, want 3", s.Len())
+		}
+		s.Remove("y")
+		got := slices.Sorted(s.All())
+		want := []string{"x", "z"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
+
+func TestUniqueWith(t *testing.T) {
+	input := [][]string{{"a", "b"}, {"c"}, {"a", "b"}, {}, {"c"}, {}}
+	got := hof.UniqueWith(input, hof.StringsHasher())
+	want := [][]string{{"a", "b"}, {"c"}, {}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestGroupByWith(t *testing.T) {
+	type file struct {
+		Name    string
+		Content []byte
+	}
+	files := []file{
+		{"a.txt", []byte("same")},
+		{"b.txt", []byte("other")},
+		{"c.txt", []byte("same")},
+	}
+
+	got := hof.GroupByWith(files, func(f file) []byte { return f.Content }, hof.BytesHasher())
+
+	if len(got) != 2 {
+		t.Fatalf("GroupByWith() returned %d groups, want 2", len(got))
+	}
+	if string(got[0].Key) != "same" || len(got[0].Items) != 2 || got[0].Items[1].Name != "c.txt" {
+		t.Errorf("first group = %+v", got[0])
+	}
+	if string(got[1].Key) != "other" || len(got[1].Items) != 1 {
+		t.Errorf("second group = %+v", got[1])
+	}
+}
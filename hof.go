@@ -41,6 +41,49 @@ func Reduce[E any, T any](arr []E, fn func(T, E) T, init T) T {
 	return acc
 }
 
+// ReduceWhile : Accumulate values until fn reports false; the accumulator it returns with false is kept
+func ReduceWhile[E any, T any](arr []E, fn func(T, E) (T, bool), init T) T {
+	acc := init
+	for _, v := range arr {
+		var ok bool
+		if acc, ok = fn(acc, v); !ok {
+			break
+		}
+	}
+	return acc
+}
+
+// ReduceUntil : Accumulate values until the accumulator satisfies stop
+func ReduceUntil[E any, T any](arr []E, fn func(T, E) T, stop func(T) bool, init T) T {
+	return ReduceWhile(arr, func(acc T, v E) (T, bool) {
+		acc = fn(acc, v)
+		return acc, !stop(acc)
+	}, init)
+}
+
+// ReduceRight : Accumulate values from last to first, like JavaScript's reduceRight
+func ReduceRight[E any, T any](arr []E, fn func(T, E) T, init T) T {
+	acc := init
+	for _, v := range slices.Backward(arr) {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// FoldRight : Right fold with the element first, so fn(arr[0], fn(arr[1], ... fn(arr[n-1], init)))
+func FoldRight[E any, T any](arr []E, fn func(E, T) T, init T) T {
+	return ReduceRight(arr, func(acc T, v E) T { return fn(v, acc) }, init)
+}
+
+// Reduce1 : Accumulate values seeded with the first element; false when arr is empty
+func Reduce1[E any](arr []E, fn func(E, E) E) (E, bool) {
+	if len(arr) == 0 {
+		var zero E
+		return zero, false
+	}
+	return Reduce(arr[1:], fn, arr[0]), true
+}
+
 // ForEach : Apply side-effects (printing, logging, etc.)
 func ForEach[E any](arr []E, fn func(E)) {
 	for _, v := range arr {
@@ -162,6 +205,12 @@ func Max[E Number](arr []E) E {
 
 // Collection Utilities
 
+// Entry : Key/value pair
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
 // GroupBy : Cluster elements by key
 func GroupBy[T any, K comparable](arr []T, keyFn func(T) K) map[K][]T {
 	groups := make(map[K][]T)
@@ -187,11 +236,11 @@ func Partition[T any](arr []T, fn func(T) bool) ([]T, []T) {
 
 // Unique : Remove duplicates
 func Unique[T comparable](arr []T) []T {
-	seen := make(map[T]struct{})
+	seen := make(Set[T])
 	var result []T
 	for _, v := range arr {
-		if _, ok := seen[v]; !ok {
-			seen[v] = struct{}{}
+		if !seen.Has(v) {
+			seen.Add(v)
 			result = append(result, v)
 		}
 	}
@@ -230,6 +279,9 @@ func FlatMap[T any, U any](arr []T, fn func(T) []U) []U {
 }
 
 // Chunk : Split slice into groups
+//
+// Chunks share arr's memory but have capped capacity, so appending to one
+// reallocates instead of overwriting the next chunk.
 func Chunk[T any](arr []T, size int) [][]T {
 	if size <= 0 {
 		return nil
@@ -238,7 +290,7 @@ func Chunk[T any](arr []T, size int) [][]T {
 	for i := 0; i < len(arr); i += size {
 		end := i + size
 		end = min(end, len(arr))
-		chunks = append(chunks, arr[i:end])
+		chunks = append(chunks, arr[i:end:end])
 	}
 	return chunks
 }
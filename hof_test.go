@@ -209,6 +209,99 @@ func TestReduce(t *testing.T) {
 	})
 }
 
+func TestReduceWhile(t *testing.T) {
+	costs := []int{30, 20, 40, 50, 10}
+	budget := 80
+
+	t.Run("stop before budget is exceeded", func(t *testing.T) {
+		calls := 0
+		got := hof.ReduceWhile(costs, func(total, c int) (int, bool) {
+			calls++
+			if total+c > budget {
+				return total, false
+			}
+			return total + c, true
+		}, 0)
+
+		if got != 50 || calls != 3 {
+			t.Errorf("got %v after %d calls, want 50 after 3", got, calls)
+		}
+	})
+
+	t.Run("runs to the end", func(t *testing.T) {
+		got := hof.ReduceWhile(costs, func(total, c int) (int, bool) { return total + c, true }, 0)
+		if got != hof.Sum(costs) {
+			t.Errorf("got %v, want %v", got, hof.Sum(costs))
+		}
+	})
+
+	t.Run("until budget exceeded", func(t *testing.T) {
+		got := hof.ReduceUntil(costs, func(total, c int) int { return total + c }, func(total int) bool { return total > budget }, 0)
+		if got != 90 {
+			t.Errorf("got %v, want 90", got)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got := hof.ReduceUntil([]int{}, func(total, c int) int { return total + c }, func(int) bool { return true }, 7)
+		if got != 7 {
+			t.Errorf("got %v, want 7", got)
+		}
+	})
+}
+
+func TestReduceRight(t *testing.T) {
+	t.Run("visits from the end", func(t *testing.T) {
+		got := hof.ReduceRight([]string{"a", "b", "c"}, func(acc, v string) string { return acc + v }, "")
+		if got != "cba" {
+			t.Errorf("got %q, want %q", got, "cba")
+		}
+	})
+
+	t.Run("flatten like JavaScript", func(t *testing.T) {
+		got := hof.ReduceRight([][]int{{0, 1}, {2, 3}, {4, 5}}, func(acc, v []int) []int { return append(acc, v...) }, nil)
+		if !reflect.DeepEqual(got, []int{4, 5, 2, 3, 0, 1}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("fold right builds in order", func(t *testing.T) {
+		got := hof.FoldRight([]int{1, 2, 3}, func(v int, acc []int) []int { return append([]int{v}, acc...) }, nil)
+		if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("fold right subtraction", func(t *testing.T) {
+		// 1 - (2 - (3 - 0))
+		if got := hof.FoldRight([]int{1, 2, 3}, func(v, acc int) int { return v - acc }, 0); got != 2 {
+			t.Errorf("got %v, want 2", got)
+		}
+	})
+}
+
+func TestReduce1(t *testing.T) {
+	t.Run("seeds from first element", func(t *testing.T) {
+		got, ok := hof.Reduce1([]int{3, 9, 4}, func(a, b int) int { return max(a, b) })
+		if !ok || got != 9 {
+			t.Errorf("got %v, %v, want 9, true", got, ok)
+		}
+	})
+
+	t.Run("single element", func(t *testing.T) {
+		got, ok := hof.Reduce1([]string{"only"}, func(a, b string) string { return a + b })
+		if !ok || got != "only" {
+			t.Errorf("got %q, %v", got, ok)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		if got, ok := hof.Reduce1([]int{}, func(a, b int) int { return a + b }); ok || got != 0 {
+			t.Errorf("got %v, %v, want 0, false", got, ok)
+		}
+	})
+}
+
 func floatAlmostEqual(a, b, epsilon float64) bool {
 	return math.Abs(a-b) < epsilon
 }
@@ -0,0 +1,113 @@
+package hof
+
+import "cmp"
+
+// Relational Joins
+//
+// Hash joins index the right slice by key and emit results in left order,
+// with matching right elements in right order.
+
+// InnerJoin : Combine every left/right pair sharing a key
+func InnerJoin[L, R any, K comparable, Out any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(L, R) Out) []Out {
+	index := GroupBy(right, rightKey)
+	var result []Out
+	for _, l := range left {
+		for _, r := range index[leftKey(l)] {
+			result = append(result, combine(l, r))
+		}
+	}
+	return result
+}
+
+// LeftJoin : Combine every left element with its matches, or with None when unmatched
+func LeftJoin[L, R any, K comparable, Out any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(L, Option[R]) Out) []Out {
+	index := GroupBy(right, rightKey)
+	var result []Out
+	for _, l := range left {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			result = append(result, combine(l, None[R]()))
+			continue
+		}
+		for _, r := range matches {
+			result = append(result, combine(l, OptionOf(r)))
+		}
+	}
+	return result
+}
+
+// FullOuterJoin : Left join followed by the unmatched right elements paired with None
+func FullOuterJoin[L, R any, K comparable, Out any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(Option[L], Option[R]) Out) []Out {
+	index := GroupBy(right, rightKey)
+	matched := make(Set[K])
+	var result []Out
+	for _, l := range left {
+		key := leftKey(l)
+		matches := index[key]
+		if len(matches) == 0 {
+			result = append(result, combine(OptionOf(l), None[R]()))
+			continue
+		}
+		matched.Add(key)
+		for _, r := range matches {
+			result = append(result, combine(OptionOf(l), OptionOf(r)))
+		}
+	}
+	for _, r := range right {
+		if !matched.Has(rightKey(r)) {
+			result = append(result, combine(None[L](), OptionOf(r)))
+		}
+	}
+	return result
+}
+
+// SemiJoin : Left elements with at least one match
+func SemiJoin[L, R any, K comparable](left []L, right []R, leftKey func(L) K, rightKey func(R) K) []L {
+	keys := CollectSet(Map(right, rightKey))
+	var result []L
+	for _, l := range left {
+		if keys.Has(leftKey(l)) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// AntiJoin : Left elements with no match
+func AntiJoin[L, R any, K comparable](left []L, right []R, leftKey func(L) K, rightKey func(R) K) []L {
+	keys := CollectSet(Map(right, rightKey))
+	var result []L
+	for _, l := range left {
+		if !keys.Has(leftKey(l)) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// MergeJoin : Inner join of inputs already sorted ascending by key, without hashing
+func MergeJoin[L, R any, K cmp.Ordered, Out any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(L, R) Out) []Out {
+	var result []Out
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		lk, rk := leftKey(left[i]), rightKey(right[j])
+		switch cmp.Compare(lk, rk) {
+		case -1:
+			i++
+		case 1:
+			j++
+		default:
+			end := j
+			for end < len(right) && cmp.Compare(rightKey(right[end]), lk) == 0 {
+				end++
+			}
+			for ; i < len(left) && cmp.Compare(leftKey(left[i]), lk) == 0; i++ {
+				for _, r := range right[j:end] {
+					result = append(result, combine(left[i], r))
+				}
+			}
+			j = end
+		}
+	}
+	return result
+}
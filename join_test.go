@@ -0,0 +1,148 @@
+package hof_test
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+type customer struct {
+	ID   int
+	Name string
+}
+
+type order struct {
+	ID         int
+	CustomerID int
+}
+
+var (
+	customers = []customer{{1, "ann"}, {2, "bob"}, {3, "cid"}}
+	orders    = []order{{10, 1}, {11, 3}, {12, 1}, {13, 4}}
+)
+
+func customerID(c customer) int { return c.ID }
+func orderCustomer(o order) int { return o.CustomerID }
+
+func optString[T any](o hof.Option[T]) string {
+	if v, ok := o.Get(); ok {
+		return fmt.Sprint(v)
+	}
+	return "-"
+}
+
+func TestInnerJoin(t *testing.T) {
+	t.Run("matches in left order", func(t *testing.T) {
+		got := hof.InnerJoin(customers, orders, customerID, orderCustomer, func(c customer, o order) string {
+			return fmt.Sprintf("%s:%d", c.Name, o.ID)
+		})
+		want := []string{"ann:10", "ann:12", "cid:11"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("empty side", func(t *testing.T) {
+		got := hof.InnerJoin(customers, []order{}, customerID, orderCustomer, func(c customer, o order) int { return o.ID })
+		if len(got) != 0 {
+			t.Errorf("InnerJoin() = %v, want empty", got)
+		}
+	})
+}
+
+func TestLeftJoin(t *testing.T) {
+	got := hof.LeftJoin(customers, orders, customerID, orderCustomer, func(c customer, o hof.Option[order]) string {
+		if v, ok := o.Get(); ok {
+			return fmt.Sprintf("%s:%d", c.Name, v.ID)
+		}
+		return c.Name + ":-"
+	})
+	want := []string{"ann:10", "ann:12", "bob:-", "cid:11"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	got := hof.FullOuterJoin(customers, orders, customerID, orderCustomer, func(c hof.Option[customer], o hof.Option[order]) string {
+		return optString(c) + "|" + optString(o)
+	})
+	want := []string{
+		"{1 ann}|{10 1}",
+		"{1 ann}|{12 1}",
+		"{2 bob}|-",
+		"{3 cid}|{11 3}",
+		"-|{13 4}",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestSemiJoin(t *testing.T) {
+	got := hof.SemiJoin(customers, orders, customerID, orderCustomer)
+	want := []customer{{1, "ann"}, {3, "cid"}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestAntiJoin(t *testing.T) {
+	got := hof.AntiJoin(customers, orders, customerID, orderCustomer)
+	want := []customer{{2, "bob"}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestMergeJoin(t *testing.T) {
+	t.Run("matches hash join on sorted input", func(t *testing.T) {
+		left := []int{1, 2, 2, 4, 6}
+		right := []int{2, 2, 3, 4, 5, 6, 6}
+		ident := func(n int) int { return n }
+		pair := func(l, r int) [2]int { return [2]int{l, r} }
+
+		got := hof.MergeJoin(left, right, ident, ident, pair)
+		want := hof.InnerJoin(left, right, ident, ident, pair)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+		if len(got) != 7 {
+			t.Errorf("MergeJoin() returned %d pairs, want 7", len(got))
+		}
+	})
+
+	t.Run("structs keyed by field", func(t *testing.T) {
+		sorted := []order{{10, 1}, {12, 1}, {11, 3}}
+		got := hof.MergeJoin(customers, sorted, customerID, orderCustomer, func(c customer, o order) string {
+			return fmt.Sprintf("%s:%d", c.Name, o.ID)
+		})
+		want := []string{"ann:10", "ann:12", "cid:11"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("NaN keys group like cmp.Compare orders them", func(t *testing.T) {
+		nan := math.NaN()
+		left := []float64{nan, 1}
+		right := []float64{nan, 1}
+		ident := func(f float64) float64 { return f }
+		got := hof.MergeJoin(left, right, ident, ident, func(l, r float64) string { return fmt.Sprint(l, r) })
+
+		want := []string{"NaN NaN", "1 1"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package hof
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// Lookup Indexes
+
+// DuplicatePolicy : How KeyBy treats elements sharing a key
+type DuplicatePolicy int
+
+const (
+	KeepFirst DuplicatePolicy = iota
+	KeepLast
+	ErrorOnDuplicate
+)
+
+// ErrDuplicateKey : Two elements produced the same key under ErrorOnDuplicate
+var ErrDuplicateKey = errors.New("hof: duplicate key")
+
+// KeyBy : Build a lookup map with one element per key
+func KeyBy[T any, K comparable](arr []T, keyFn func(T) K, policy DuplicatePolicy) (map[K]T, error) {
+	out := make(map[K]T, len(arr))
+	for _, v := range arr {
+		key := keyFn(v)
+		if _, ok := out[key]; ok {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnDuplicate:
+				return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+			}
+		}
+		out[key] = v
+	}
+	return out, nil
+}
+
+// Associate : Build a map from key/value pairs derived from each element, later keys winning
+func Associate[T any, K comparable, V any](arr []T, fn func(T) (K, V)) map[K]V {
+	out := make(map[K]V, len(arr))
+	for _, v := range arr {
+		k, val := fn(v)
+		out[k] = val
+	}
+	return out
+}
+
+// Index : Elements grouped by a key, in first-seen key order
+type Index[T any, K comparable] struct {
+	groups *OrderedMap[K, []T]
+}
+
+// IndexBy : Index elements by keyFn; for several columns, return a struct of them as the key
+//
+// A typed key keeps Lookup honest: a lookup value of the wrong type does not
+// compile, rather than silently missing as int(1) would against int64(1).
+func IndexBy[T any, K comparable](arr []T, keyFn func(T) K) *Index[T, K] {
+	return &Index[T, K]{groups: GroupByOrdered(arr, keyFn)}
+}
+
+// Lookup : Elements whose key equals key, in input order
+func (idx *Index[T, K]) Lookup(key K) []T {
+	items, _ := idx.groups.Get(key)
+	return items
+}
+
+// Len : Number of distinct keys
+func (idx *Index[T, K]) Len() int {
+	return idx.groups.Len()
+}
+
+// All : Iterate over keys and their elements in first-seen order
+func (idx *Index[T, K]) All() iter.Seq2[K, []T] {
+	return idx.groups.All()
+}
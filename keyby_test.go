@@ -0,0 +1,121 @@
+package hof_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestKeyBy(t *testing.T) {
+	t.Run("keep first", func(t *testing.T) {
+		got, err := hof.KeyBy(users, userID, hof.KeepFirst)
+		want := map[int]user{1: {1, "ann"}, 2: {2, "bob"}, 3: {3, "cid"}}
+
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("KeyBy() = %v, %v, want %v", got, err, want)
+		}
+	})
+
+	t.Run("keep last", func(t *testing.T) {
+		got, err := hof.KeyBy(users, userID, hof.KeepLast)
+		want := map[int]user{1: {1, "ann v2"}, 2: {2, "bob v2"}, 3: {3, "cid"}}
+
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("KeyBy() = %v, %v, want %v", got, err, want)
+		}
+	})
+
+	t.Run("error on duplicate", func(t *testing.T) {
+		got, err := hof.KeyBy(users, userID, hof.ErrorOnDuplicate)
+
+		if !errors.Is(err, hof.ErrDuplicateKey) || got != nil {
+			t.Errorf("KeyBy() = %v, %v, want ErrDuplicateKey", got, err)
+		}
+		if err != nil && err.Error() != "hof: duplicate key: 1" {
+			t.Errorf("error message = %q", err.Error())
+		}
+	})
+
+	t.Run("unique keys never error", func(t *testing.T) {
+		got, err := hof.KeyBy(customers, customerID, hof.ErrorOnDuplicate)
+
+		if err != nil || len(got) != 3 {
+			t.Errorf("KeyBy() = %v, %v", got, err)
+		}
+	})
+}
+
+func TestAssociate(t *testing.T) {
+	got := hof.Associate(customers, func(c customer) (string, int) { return c.Name, c.ID })
+	want := map[string]int{"ann": 1, "bob": 2, "cid": 3}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Associate() = %v, want %v", got, want)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	type regionItem struct {
+		Region, Item string
+	}
+	idx := hof.IndexBy(sales, func(s sale) regionItem { return regionItem{s.Region, s.Item} })
+
+	t.Run("lookup composite key", func(t *testing.T) {
+		got := idx.Lookup(regionItem{"north", "coffee"})
+		want := []sale{{"north", "coffee", 30}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		if got := idx.Lookup(regionItem{"east", "tea"}); got != nil {
+			t.Errorf("Lookup() = %v, want nil", got)
+		}
+		if got := idx.Lookup(regionItem{Region: "north"}); got != nil {
+			t.Errorf("Lookup() with a partial key = %v, want nil", got)
+		}
+	})
+
+	t.Run("keys in first-seen order", func(t *testing.T) {
+		var keys []regionItem
+		for k := range idx.All() {
+			keys = append(keys, k)
+		}
+		want := []regionItem{
+			{"north", "tea"},
+			{"south", "coffee"},
+			{"north", "coffee"},
+			{"south", "tea"},
+			{"north", "cake"},
+		}
+
+		if idx.Len() != 5 || !reflect.DeepEqual(keys, want) {
+			t.Errorf("got:%v\nwant:%v", keys, want)
+		}
+	})
+
+	t.Run("mixed column types", func(t *testing.T) {
+		type regionLarge struct {
+			Region string
+			Large  bool
+		}
+		idx := hof.IndexBy(sales, func(s sale) regionLarge { return regionLarge{s.Region, s.Amount >= 20} })
+		got := idx.Lookup(regionLarge{"north", true})
+
+		if len(got) != 2 {
+			t.Errorf("Lookup() = %v, want two sales", got)
+		}
+	})
+
+	t.Run("typed numeric key", func(t *testing.T) {
+		idx := hof.IndexBy(sales, func(s sale) int64 { return int64(s.Amount) })
+		// An untyped constant converts to the key type, so 30 matches int64(30).
+		if got := idx.Lookup(30); len(got) != 1 || got[0].Amount != 30 {
+			t.Errorf("Lookup(30) = %v", got)
+		}
+	})
+}
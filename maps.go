@@ -0,0 +1,100 @@
+package hof
+
+import (
+	"iter"
+	"maps"
+)
+
+// Map Utilities
+//
+// Go map iteration order is random, so where several source entries land on
+// the same key the resolve callback should not depend on which comes first.
+
+// MapValues : Transform each value, keeping keys
+func MapValues[K comparable, V, W any](m map[K]V, fn func(V) W) map[K]W {
+	out := make(map[K]W, len(m))
+	for k, v := range m {
+		out[k] = fn(v)
+	}
+	return out
+}
+
+// MapKeys : Transform each key; resolve(key, existing, incoming) settles collisions, nil keeps an arbitrary one
+func MapKeys[K, K2 comparable, V any](m map[K]V, fn func(K) K2, resolve func(key K2, a, b V) V) map[K2]V {
+	out := make(map[K2]V, len(m))
+	for k, v := range m {
+		key := fn(k)
+		if prev, ok := out[key]; ok && resolve != nil {
+			v = resolve(key, prev, v)
+		}
+		out[key] = v
+	}
+	return out
+}
+
+// FilterMapEntries : Keep entries that satisfy a condition
+func FilterMapEntries[K comparable, V any](m map[K]V, fn func(K, V) bool) map[K]V {
+	out := make(map[K]V)
+	for k, v := range m {
+		if fn(k, v) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// InvertMap : Swap keys and values; duplicate values keep an arbitrary key
+func InvertMap[K, V comparable](m map[K]V) map[V]K {
+	out := make(map[V]K, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+// MergeMaps : Combine maps left to right; resolve(key, earlier, later) settles conflicts, nil keeps later
+func MergeMaps[K comparable, V any](resolve func(key K, a, b V) V, ms ...map[K]V) map[K]V {
+	out := make(map[K]V)
+	for _, m := range ms {
+		for k, v := range m {
+			if prev, ok := out[k]; ok && resolve != nil {
+				v = resolve(k, prev, v)
+			}
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// PickKeys : Keep only the listed keys
+func PickKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	out := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// OmitKeys : Drop the listed keys
+func OmitKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	out := maps.Clone(m)
+	if out == nil {
+		out = make(map[K]V)
+	}
+	for _, k := range keys {
+		delete(out, k)
+	}
+	return out
+}
+
+// ToEntries : Iterate over a map's key/value pairs
+func ToEntries[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return maps.All(m)
+}
+
+// FromEntries : Collect key/value pairs into a map, later keys overwriting earlier ones
+func FromEntries[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	return maps.Collect(seq)
+}
@@ -0,0 +1,144 @@
+package hof_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestMapValues(t *testing.T) {
+	groups := hof.GroupBy([]string{"cat", "dog", "bird"}, func(s string) int { return len(s) })
+	got := hof.MapValues(groups, func(g []string) int { return len(g) })
+	want := map[int]int{3: 2, 4: 1}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapValues() = %v, want %v", got, want)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "A": 2, "b": 3}
+
+	t.Run("resolve collisions", func(t *testing.T) {
+		got := hof.MapKeys(m, strings.ToUpper, func(_ string, a, b int) int { return a + b })
+		want := map[string]int{"A": 3, "B": 3}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("MapKeys() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("nil resolve keeps one", func(t *testing.T) {
+		got := hof.MapKeys(m, strings.ToUpper, nil)
+
+		if len(got) != 2 || (got["A"] != 1 && got["A"] != 2) {
+			t.Errorf("MapKeys() = %v", got)
+		}
+	})
+}
+
+func TestFilterMapEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "bb": 2, "cc": 30}
+	got := hof.FilterMapEntries(m, func(k string, v int) bool { return len(k) == 2 && v > 5 })
+	want := map[string]int{"cc": 30}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterMapEntries() = %v, want %v", got, want)
+	}
+}
+
+func TestInvertMap(t *testing.T) {
+	got := hof.InvertMap(map[string]int{"one": 1, "two": 2})
+	want := map[int]string{1: "one", 2: "two"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InvertMap() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeMaps(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 20, "z": 30}
+	c := map[string]int{"z": 300}
+
+	t.Run("later wins", func(t *testing.T) {
+		got := hof.MergeMaps(nil, a, b, c)
+		want := map[string]int{"x": 1, "y": 20, "z": 300}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("MergeMaps() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("resolver", func(t *testing.T) {
+		var conflicts []string
+		got := hof.MergeMaps(func(k string, earlier, later int) int {
+			conflicts = append(conflicts, k)
+			return earlier
+		}, a, b, c)
+		want := map[string]int{"x": 1, "y": 2, "z": 30}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("MergeMaps() = %v, want %v", got, want)
+		}
+		if len(conflicts) != 2 {
+			t.Errorf("resolver called for %v, want y and z", conflicts)
+		}
+	})
+
+	t.Run("inputs untouched", func(t *testing.T) {
+		if a["y"] != 2 || len(a) != 2 {
+			t.Errorf("MergeMaps() mutated its input: %v", a)
+		}
+	})
+}
+
+func TestPickOmitKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	t.Run("pick", func(t *testing.T) {
+		got := hof.PickKeys(m, "a", "c", "missing")
+		want := map[string]int{"a": 1, "c": 3}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("PickKeys() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("omit", func(t *testing.T) {
+		got := hof.OmitKeys(m, "a", "missing")
+		want := map[string]int{"b": 2, "c": 3}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("OmitKeys() = %v, want %v", got, want)
+		}
+		if len(m) != 3 {
+			t.Errorf("OmitKeys() mutated its input: %v", m)
+		}
+	})
+
+	t.Run("omit from nil map", func(t *testing.T) {
+		got := hof.OmitKeys[string, int](nil, "a")
+		if got == nil {
+			t.Errorf("OmitKeys() returned nil map")
+		}
+	})
+}
+
+func TestEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	got := hof.FromEntries(hof.ToEntries(m))
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("round trip = %v, want %v", got, m)
+	}
+
+	ord := hof.NewOrderedMap[string, int]()
+	ord.Set("z", 26)
+	ord.Set("z", 0)
+	if got := hof.FromEntries(ord.All()); !reflect.DeepEqual(got, map[string]int{"z": 0}) {
+		t.Errorf("FromEntries(OrderedMap.All()) = %v", got)
+	}
+}
@@ -0,0 +1,42 @@
+package hof
+
+// Optional Values
+
+// Option : Value that may be absent
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// OptionOf : Present option holding v
+func OptionOf[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None : Absent option
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Get : Value and whether it is present
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// IsSome : Report whether a value is present
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone : Report whether the value is absent
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// OrElse : Value if present, otherwise def
+func (o Option[T]) OrElse(def T) T {
+	if o.ok {
+		return o.value
+	}
+	return def
+}
@@ -0,0 +1,40 @@
+package hof_test
+
+import (
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("present", func(t *testing.T) {
+		o := hof.OptionOf(42)
+		v, ok := o.Get()
+
+		if !ok || v != 42 || !o.IsSome() || o.IsNone() {
+			t.Errorf("OptionOf(42) = (%v, %v)", v, ok)
+		}
+		if got := o.OrElse(7); got != 42 {
+			t.Errorf("OrElse() = %v, want 42", got)
+		}
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		o := hof.None[string]()
+		v, ok := o.Get()
+
+		if ok || v != "" || o.IsSome() || !o.IsNone() {
+			t.Errorf("None() = (%q, %v)", v, ok)
+		}
+		if got := o.OrElse("fallback"); got != "fallback" {
+			t.Errorf("OrElse() = %q, want fallback", got)
+		}
+	})
+
+	t.Run("zero value is absent", func(t *testing.T) {
+		var o hof.Option[int]
+		if o.IsSome() {
+			t.Errorf("zero Option should be absent")
+		}
+	})
+}
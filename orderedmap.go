@@ -0,0 +1,117 @@
+package hof
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Ordered Maps
+
+// OrderedMap : Map remembering key insertion order
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// NewOrderedMap : Build an empty ordered map
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: make(map[K]V)}
+}
+
+// Set : Store v under k; existing keys keep their position
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if m.values == nil {
+		m.values = make(map[K]V)
+	}
+	if _, ok := m.values[k]; !ok {
+		m.keys = append(m.keys, k)
+	}
+	m.values[k] = v
+}
+
+// Get : Value stored under k
+func (m *OrderedMap[K, V]) Get(k K) (V, bool) {
+	v, ok := m.values[k]
+	return v, ok
+}
+
+// Has : Report whether k is present
+func (m *OrderedMap[K, V]) Has(k K) bool {
+	_, ok := m.values[k]
+	return ok
+}
+
+// Delete : Remove k, reporting whether it was present
+func (m *OrderedMap[K, V]) Delete(k K) bool {
+	if _, ok := m.values[k]; !ok {
+		return false
+	}
+	delete(m.values, k)
+	i := slices.Index(m.keys, k)
+	m.keys = slices.Delete(m.keys, i, i+1)
+	return true
+}
+
+// Len : Number of entries
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// Keys : Iterate over keys in insertion order
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return slices.Values(m.keys)
+}
+
+// Values : Iterate over values in insertion order
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, k := range m.keys {
+			if !yield(m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// All : Iterate over entries in insertion order
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range m.keys {
+			if !yield(k, m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// GroupByOrdered : Cluster elements by key, with groups in first-seen key order
+func GroupByOrdered[T any, K comparable](arr []T, keyFn func(T) K) *OrderedMap[K, []T] {
+	groups := NewOrderedMap[K, []T]()
+	for _, v := range arr {
+		key := keyFn(v)
+		group, _ := groups.Get(key)
+		groups.Set(key, append(group, v))
+	}
+	return groups
+}
+
+// SortedKeys : Keys of m in ascending order
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
+
+// SortedKeysFunc : Keys of m ordered by cmp
+func SortedKeysFunc[K comparable, V any](m map[K]V, cmp func(a, b K) int) []K {
+	return slices.SortedFunc(maps.Keys(m), cmp)
+}
+
+// SortedEntries : Entries of m in ascending key order
+func SortedEntries[K cmp.Ordered, V any](m map[K]V) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for _, k := range SortedKeys(m) {
+		entries = append(entries, Entry[K, V]{Key: k, Value: m[k]})
+	}
+	return entries
+}
@@ -0,0 +1,117 @@
+package hof_test
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Run("preserves insertion order", func(t *testing.T) {
+		m := hof.NewOrderedMap[string, int]()
+		m.Set("c", 3)
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("c", 30)
+
+		gotKeys := slices.Collect(m.Keys())
+		gotValues := slices.Collect(m.Values())
+
+		if !reflect.DeepEqual(gotKeys, []string{"c", "a", "b"}) {
+			t.Errorf("Keys() = %v", gotKeys)
+		}
+		if !reflect.DeepEqual(gotValues, []int{30, 1, 2}) {
+			t.Errorf("Values() = %v", gotValues)
+		}
+	})
+
+	t.Run("get has delete", func(t *testing.T) {
+		var m hof.OrderedMap[int, string]
+		m.Set(1, "one")
+		m.Set(2, "two")
+		m.Set(3, "three")
+
+		if v, ok := m.Get(2); !ok || v != "two" {
+			t.Errorf("Get(2) = %q, %v", v, ok)
+		}
+		if !m.Delete(2) || m.Delete(2) {
+			t.Errorf("Delete(2) should succeed once")
+		}
+		if m.Has(2) || m.Len() != 2 {
+			t.Errorf("after Delete: Has(2) = %v, Len() = %d", m.Has(2), m.Len())
+		}
+
+		m.Set(2, "again")
+		if got := slices.Collect(m.Keys()); !reflect.DeepEqual(got, []int{1, 3, 2}) {
+			t.Errorf("re-added key should move to the end, got %v", got)
+		}
+	})
+
+	t.Run("all early termination", func(t *testing.T) {
+		m := hof.NewOrderedMap[int, int]()
+		for i := range 5 {
+			m.Set(i, i*i)
+		}
+
+		var got []int
+		for k, v := range m.All() {
+			got = append(got, k, v)
+			if k == 1 {
+				break
+			}
+		}
+
+		if !reflect.DeepEqual(got, []int{0, 0, 1, 1}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	words := []string{"kiwi", "fig", "plum", "pear", "date", "apple"}
+	got := hof.GroupByOrdered(words, func(s string) int { return len(s) })
+
+	var keys []int
+	var groups [][]string
+	for k, g := range got.All() {
+		keys = append(keys, k)
+		groups = append(groups, g)
+	}
+
+	if !reflect.DeepEqual(keys, []int{4, 3, 5}) {
+		t.Errorf("keys = %v, want [4 3 5]", keys)
+	}
+	want := [][]string{{"kiwi", "plum", "pear", "date"}, {"fig"}, {"apple"}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("got:%v\nwant:%v", groups, want)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	groups := hof.GroupBy([]int{5, 12, 7, 30, 1}, func(n int) int { return n / 10 })
+
+	t.Run("ascending", func(t *testing.T) {
+		got := hof.SortedKeys(groups)
+		if !reflect.DeepEqual(got, []int{0, 1, 3}) {
+			t.Errorf("SortedKeys() = %v", got)
+		}
+	})
+
+	t.Run("custom order", func(t *testing.T) {
+		got := hof.SortedKeysFunc(groups, func(a, b int) int { return cmp.Compare(b, a) })
+		if !reflect.DeepEqual(got, []int{3, 1, 0}) {
+			t.Errorf("SortedKeysFunc() = %v", got)
+		}
+	})
+
+	t.Run("entries", func(t *testing.T) {
+		got := hof.SortedEntries(hof.Frequencies([]string{"b", "a", "b"}))
+		want := []hof.Entry[string, int]{{"a", 1}, {"b", 2}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
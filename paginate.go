@@ -0,0 +1,90 @@
+package hof
+
+import (
+	"context"
+	"iter"
+)
+
+// Pagination
+
+// PageFunc : Fetch the page at cursor, returning its items, the next cursor and whether it was the last page
+type PageFunc[T, C any] func(ctx context.Context, cursor C) (items []T, next C, done bool, err error)
+
+// PaginateOption : Configure Paginate
+type PaginateOption func(*paginateConfig)
+
+type paginateConfig struct {
+	prefetch bool
+}
+
+// WithPrefetch : Fetch the next page concurrently while the current one is consumed
+func WithPrefetch() PaginateOption {
+	return func(c *paginateConfig) { c.prefetch = true }
+}
+
+type page[T, C any] struct {
+	items []T
+	next  C
+	done  bool
+	err   error
+}
+
+// Paginate : Lazily walk a cursor-paginated source starting from the zero cursor
+//
+// A fetch error or context cancellation is yielded once as the final pair.
+// Breaking out of the loop stops fetching and waits for any prefetch to return.
+func Paginate[T, C any](ctx context.Context, fetch PageFunc[T, C], opts ...PaginateOption) iter.Seq2[T, error] {
+	var cfg paginateConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var pending chan page[T, C]
+		defer func() {
+			cancel()
+			if pending != nil {
+				<-pending
+			}
+		}()
+
+		load := func(cursor C) page[T, C] {
+			if err := ctx.Err(); err != nil {
+				return page[T, C]{err: err}
+			}
+			items, next, done, err := fetch(ctx, cursor)
+			return page[T, C]{items: items, next: next, done: done, err: err}
+		}
+
+		var cursor C
+		cur := load(cursor)
+		for {
+			if cur.err != nil {
+				var zero T
+				yield(zero, cur.err)
+				return
+			}
+			if cfg.prefetch && !cur.done {
+				pending = make(chan page[T, C], 1)
+				go func(ch chan<- page[T, C], cursor C) {
+					ch <- load(cursor)
+				}(pending, cur.next)
+			}
+			for _, v := range cur.items {
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if cur.done {
+				return
+			}
+			if pending != nil {
+				cur = <-pending
+				pending = nil
+			} else {
+				cur = load(cur.next)
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package hof_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/suryanshu-09/hof"
+)
+
+// fakeBackend serves records in pages using an offset cursor.
+type fakeBackend struct {
+	mu       sync.Mutex
+	records  []int
+	pageSize int
+	failAt   int // cursor that returns an error, or -1
+	calls    []int
+	started  chan int
+}
+
+func newFakeBackend(n, pageSize int) *fakeBackend {
+	b := &fakeBackend{pageSize: pageSize, failAt: -1}
+	for i := range n {
+		b.records = append(b.records, i)
+	}
+	return b
+}
+
+var errBackend = errors.New("backend unavailable")
+
+func (b *fakeBackend) fetch(ctx context.Context, cursor int) ([]int, int, bool, error) {
+	b.mu.Lock()
+	b.calls = append(b.calls, cursor)
+	b.mu.Unlock()
+
+	if b.started != nil {
+		b.started <- cursor
+	}
+	if cursor == b.failAt {
+		return nil, 0, false, errBackend
+	}
+
+	end := min(cursor+b.pageSize, len(b.records))
+	return b.records[cursor:end], end, end == len(b.records), nil
+}
+
+func (b *fakeBackend) callCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.calls)
+}
+
+func collectPages(t *testing.T, seq iter.Seq2[int, error]) ([]int, error) {
+	t.Helper()
+	var got []int
+	for v, err := range seq {
+		if err != nil {
+			return got, err
+		}
+		got = append(got, v)
+	}
+	return got, nil
+}
+
+func TestPaginate(t *testing.T) {
+	for _, name := range []string{"sequential", "prefetch"} {
+		var opts []hof.PaginateOption
+		if name == "prefetch" {
+			opts = append(opts, hof.WithPrefetch())
+		}
+
+		t.Run(name+" all pages", func(t *testing.T) {
+			b := newFakeBackend(7, 3)
+			got, err := collectPages(t, hof.Paginate(context.Background(), b.fetch, opts...))
+			want := []int{0, 1, 2, 3, 4, 5, 6}
+
+			if err != nil || !reflect.DeepEqual(got, want) {
+				t.Errorf("got:%v, %v\nwant:%v", got, err, want)
+			}
+			if !reflect.DeepEqual(b.calls, []int{0, 3, 6}) {
+				t.Errorf("fetched cursors %v, want [0 3 6]", b.calls)
+			}
+		})
+
+		t.Run(name+" error ends sequence", func(t *testing.T) {
+			b := newFakeBackend(9, 3)
+			b.failAt = 3
+			got, err := collectPages(t, hof.Paginate(context.Background(), b.fetch, opts...))
+
+			if !errors.Is(err, errBackend) || !reflect.DeepEqual(got, []int{0, 1, 2}) {
+				t.Errorf("got:%v, %v", got, err)
+			}
+		})
+	}
+
+	t.Run("empty source", func(t *testing.T) {
+		b := newFakeBackend(0, 3)
+		got, err := collectPages(t, hof.Paginate(context.Background(), b.fetch))
+
+		if err != nil || len(got) != 0 || b.callCount() != 1 {
+			t.Errorf("got:%v, %v after %d calls", got, err, b.callCount())
+		}
+	})
+
+	t.Run("early break stops fetching", func(t *testing.T) {
+		b := newFakeBackend(100, 10)
+		for v, err := range hof.Paginate(context.Background(), b.fetch) {
+			if err != nil || v == 12 {
+				break
+			}
+		}
+
+		if b.callCount() != 2 {
+			t.Errorf("fetched %d pages, want 2", b.callCount())
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		b := newFakeBackend(10, 3)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var got []int
+		var gotErr error
+		for v, err := range hof.Paginate(ctx, b.fetch) {
+			if err != nil {
+				gotErr = err
+				break
+			}
+			got = append(got, v)
+			cancel()
+		}
+
+		if !errors.Is(gotErr, context.Canceled) || !reflect.DeepEqual(got, []int{0, 1, 2}) {
+			t.Errorf("got:%v, %v", got, gotErr)
+		}
+	})
+}
+
+func TestPaginatePrefetch(t *testing.T) {
+	t.Run("next page requested while consuming", func(t *testing.T) {
+		b := newFakeBackend(6, 3)
+		b.started = make(chan int, 10)
+
+		for v, err := range hof.Paginate(context.Background(), b.fetch, hof.WithPrefetch()) {
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if v == 0 {
+				<-b.started
+				select {
+				case cursor := <-b.started:
+					if cursor != 3 {
+						t.Errorf("prefetched cursor %d, want 3", cursor)
+					}
+				case <-time.After(time.Second):
+					t.Fatal("next page was not prefetched")
+				}
+			}
+		}
+	})
+
+	t.Run("early break cancels and waits for prefetch", func(t *testing.T) {
+		var cancelled atomic.Bool
+		prefetching := make(chan struct{})
+		fetch := func(ctx context.Context, cursor int) ([]int, int, bool, error) {
+			if cursor == 0 {
+				return []int{1, 2, 3}, 1, false, nil
+			}
+			close(prefetching)
+			<-ctx.Done()
+			cancelled.Store(true)
+			return nil, 0, false, ctx.Err()
+		}
+
+		for range hof.Paginate(context.Background(), fetch, hof.WithPrefetch()) {
+			<-prefetching
+			break
+		}
+
+		if !cancelled.Load() {
+			t.Errorf("prefetch was not cancelled before Paginate returned")
+		}
+	})
+}
@@ -0,0 +1,198 @@
+// Package pipeline: Concurrent channel stages built from the same callbacks as hof.Map and hof.Filter
+package pipeline
+
+import (
+	"context"
+	"hash/maphash"
+	"sync"
+)
+
+// Option : Configure a stage
+type Option func(*config)
+
+type config struct {
+	buffer int
+}
+
+// WithBuffer : Bound a stage's output channel to n items; a full buffer blocks upstream
+func WithBuffer(n int) Option {
+	return func(c *config) { c.buffer = max(n, 0) }
+}
+
+func newConfig(opts []Option) config {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// send delivers v unless ctx is cancelled first.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// receive takes the next value from in unless it closes or ctx is cancelled.
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case v, ok := <-in:
+		return v, ok
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
+
+// Stage : Transform every value with fn across workers goroutines; output order is not preserved when workers > 1
+func Stage[In, Out any](ctx context.Context, in <-chan In, workers int, fn func(In) Out, opts ...Option) <-chan Out {
+	cfg := newConfig(opts)
+	out := make(chan Out, cfg.buffer)
+
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Go(func() {
+			for {
+				v, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, fn(v)) {
+					return
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FilterStage : Keep values that satisfy fn across workers goroutines
+func FilterStage[T any](ctx context.Context, in <-chan T, workers int, fn func(T) bool, opts ...Option) <-chan T {
+	cfg := newConfig(opts)
+	out := make(chan T, cfg.buffer)
+
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Go(func() {
+			for {
+				v, ok := receive(ctx, in)
+				if !ok {
+					return
+				}
+				if fn(v) && !send(ctx, out, v) {
+					return
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanIn : Merge several channels into one that closes after all of them do
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	for _, in := range ins {
+		wg.Go(func() {
+			for {
+				v, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Router : Choose which of n outputs receives v
+type Router[T any] func(v T, n int) int
+
+// RoundRobin : Router cycling through the outputs; each call returns a fresh router
+func RoundRobin[T any]() Router[T] {
+	next := 0
+	return func(_ T, n int) int {
+		i := next % n
+		next = i + 1
+		return i
+	}
+}
+
+// KeyHash : Router sending values with equal keys to the same output
+func KeyHash[T any, K comparable](keyFn func(T) K) Router[T] {
+	seed := maphash.MakeSeed()
+	return func(v T, n int) int {
+		return int(maphash.Comparable(seed, keyFn(v)) % uint64(n))
+	}
+}
+
+// FanOut : Split one channel into n by route; a slow output holds back the rest
+func FanOut[T any](ctx context.Context, in <-chan T, n int, route Router[T], opts ...Option) []<-chan T {
+	cfg := newConfig(opts)
+	outs := make([]chan T, max(n, 1))
+	result := make([]<-chan T, len(outs))
+	for i := range outs {
+		outs[i] = make(chan T, cfg.buffer)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		for {
+			v, ok := receive(ctx, in)
+			if !ok || !send(ctx, outs[route(v, len(outs))], v) {
+				return
+			}
+		}
+	}()
+	return result
+}
+
+// Broadcast : Copy every value to each of n outputs; a slow output holds back the rest
+func Broadcast[T any](ctx context.Context, in <-chan T, n int, opts ...Option) []<-chan T {
+	cfg := newConfig(opts)
+	outs := make([]chan T, max(n, 1))
+	result := make([]<-chan T, len(outs))
+	for i := range outs {
+		outs[i] = make(chan T, cfg.buffer)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		for {
+			v, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			for _, out := range outs {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}
+	}()
+	return result
+}
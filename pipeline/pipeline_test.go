@@ -0,0 +1,192 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+
+	"github.com/suryanshu-09/hof"
+	"github.com/suryanshu-09/hof/pipeline"
+)
+
+// Tests run inside synctest bubbles, which fail if a stage goroutine is still
+// blocked when the test function returns.
+
+func source(ctx context.Context, n int) <-chan int {
+	return hof.ToChan(ctx, hof.Range(0, n, 1), 0)
+}
+
+func TestStage(t *testing.T) {
+	label := func(n int) string { return fmt.Sprintf("item_%d", n) }
+
+	t.Run("same callback as Map", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			input := slices.Collect(hof.Range(0, 20, 1))
+
+			got := slices.Sorted(hof.FromChan(pipeline.Stage(ctx, source(ctx, 20), 4, label)))
+			want := slices.Sorted(hof.Map(input, label))
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got:%v\nwant:%v", got, want)
+			}
+		})
+	})
+
+	t.Run("single worker keeps order", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			got := slices.Collect(hof.FromChan(pipeline.Stage(ctx, source(ctx, 5), 1, func(n int) int { return n * n })))
+
+			if !reflect.DeepEqual(got, []int{0, 1, 4, 9, 16}) {
+				t.Errorf("got:%v", got)
+			}
+		})
+	})
+
+	t.Run("chained stages", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			even := func(n int) bool { return n%2 == 0 }
+			evens := pipeline.FilterStage(ctx, source(ctx, 10), 3, even)
+			got := slices.Sorted(hof.FromChan(pipeline.Stage(ctx, evens, 2, label)))
+
+			want := slices.Sorted(hof.Map(slices.Collect(hof.Filter(slices.Collect(hof.Range(0, 10, 1)), even)), label))
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got:%v\nwant:%v", got, want)
+			}
+		})
+	})
+
+	t.Run("cancel stops workers", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			in := hof.ToChan(ctx, hof.Iterate(0, func(n int) int { return n + 1 }), 0)
+			out := pipeline.Stage(ctx, in, 4, label)
+
+			<-out
+			cancel()
+		})
+	})
+}
+
+func TestBackpressure(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var processed atomic.Int32
+		in := hof.ToChan(ctx, hof.Range(0, 100, 1), 0)
+		out := pipeline.Stage(ctx, in, 1, func(n int) int {
+			processed.Add(1)
+			return n
+		}, pipeline.WithBuffer(3))
+
+		synctest.Wait()
+		// Three results fill the buffer and a fourth is held by the blocked worker.
+		if got := processed.Load(); got != 4 {
+			t.Errorf("processed %d values with no consumer, want 4", got)
+		}
+
+		<-out
+		synctest.Wait()
+		if got := processed.Load(); got != 5 {
+			t.Errorf("processed %d values after one read, want 5", got)
+		}
+	})
+}
+
+func TestFanIn(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx := context.Background()
+		a := hof.ToChan(ctx, hof.Range(0, 5, 1), 0)
+		b := hof.ToChan(ctx, hof.Range(100, 103, 1), 0)
+
+		got := slices.Sorted(hof.FromChan(pipeline.FanIn(ctx, a, b)))
+		want := []int{0, 1, 2, 3, 4, 100, 101, 102}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
+
+func TestFanOut(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			outs := pipeline.FanOut(ctx, source(ctx, 7), 3, pipeline.RoundRobin[int](), pipeline.WithBuffer(10))
+			synctest.Wait()
+
+			var got [][]int
+			for _, out := range outs {
+				got = append(got, slices.Collect(hof.FromChan(out)))
+			}
+			want := [][]int{{0, 3, 6}, {1, 4}, {2, 5}}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got:%v\nwant:%v", got, want)
+			}
+		})
+	})
+
+	t.Run("key hash", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			words := []string{"apple", "avocado", "banana", "blueberry", "cherry", "apricot"}
+			in := hof.ToChan(ctx, slices.Values(words), 0)
+			firstLetter := func(s string) byte { return s[0] }
+			outs := pipeline.FanOut(ctx, in, 4, pipeline.KeyHash(firstLetter), pipeline.WithBuffer(len(words)))
+			synctest.Wait()
+
+			owner := make(map[byte]int)
+			total := 0
+			for i, out := range outs {
+				for w := range hof.FromChan(out) {
+					total++
+					if prev, ok := owner[w[0]]; ok && prev != i {
+						t.Errorf("key %q routed to outputs %d and %d", w[0], prev, i)
+					}
+					owner[w[0]] = i
+				}
+			}
+			if total != len(words) {
+				t.Errorf("routed %d values, want %d", total, len(words))
+			}
+		})
+	})
+
+	t.Run("fan out then fan in", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			outs := pipeline.FanOut(ctx, source(ctx, 50), 3, pipeline.RoundRobin[int]())
+			var stages []<-chan int
+			for _, out := range outs {
+				stages = append(stages, pipeline.Stage(ctx, out, 1, func(n int) int { return n * 2 }))
+			}
+
+			got := hof.Sum(slices.Collect(hof.FromChan(pipeline.FanIn(ctx, stages...))))
+			if want := 2 * hof.Sum(slices.Collect(hof.Range(0, 50, 1))); got != want {
+				t.Errorf("sum = %d, want %d", got, want)
+			}
+		})
+	})
+}
+
+func TestBroadcast(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx := context.Background()
+		outs := pipeline.Broadcast(ctx, source(ctx, 4), 2, pipeline.WithBuffer(4))
+		synctest.Wait()
+
+		for i, out := range outs {
+			if got := slices.Collect(hof.FromChan(out)); !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+				t.Errorf("output %d got:%v", i, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package hof
+
+// Reconciliation
+
+// Change : Old and new versions of an element whose key is in both collections
+type Change[T any] struct {
+	Old T
+	New T
+}
+
+// Reconciliation : Outcome of comparing two keyed collections
+type Reconciliation[T any] struct {
+	Added     []T         // in new only, in new order
+	Removed   []T         // in old only, in old order
+	Changed   []Change[T] // in both but not equal, in new order
+	Unchanged []T         // in both and equal, in new order
+}
+
+// Reconcile : Diff oldArr against newArr by key into added, removed, changed and unchanged
+//
+// Keys are expected to be unique within each slice; for repeated keys the last
+// occurrence wins.
+func Reconcile[T any, K comparable](oldArr, newArr []T, keyFn func(T) K, equalFn func(a, b T) bool) Reconciliation[T] {
+	var r Reconciliation[T]
+
+	before := make(map[K]T, len(oldArr))
+	for _, v := range oldArr {
+		before[keyFn(v)] = v
+	}
+	after := make(map[K]T, len(newArr))
+	for _, v := range newArr {
+		after[keyFn(v)] = v
+	}
+
+	seen := make(Set[K], len(newArr))
+	for _, v := range newArr {
+		key := keyFn(v)
+		if seen.Has(key) {
+			continue
+		}
+		seen.Add(key)
+		cur := after[key]
+		prev, ok := before[key]
+		switch {
+		case !ok:
+			r.Added = append(r.Added, cur)
+		case equalFn(prev, cur):
+			r.Unchanged = append(r.Unchanged, cur)
+		default:
+			r.Changed = append(r.Changed, Change[T]{Old: prev, New: cur})
+		}
+	}
+
+	for _, v := range oldArr {
+		key := keyFn(v)
+		if _, ok := after[key]; !ok && !seen.Has(key) {
+			seen.Add(key)
+			r.Removed = append(r.Removed, before[key])
+		}
+	}
+	return r
+}
+
+// HasChanges : Report whether anything was added, removed or changed
+func (r Reconciliation[T]) HasChanges() bool {
+	return len(r.Added) > 0 || len(r.Removed) > 0 || len(r.Changed) > 0
+}
@@ -0,0 +1,70 @@
+package hof_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+type setting struct {
+	Key   string
+	Value string
+}
+
+func settingKey(s setting) string { return s.Key }
+
+func settingEqual(a, b setting) bool { return a == b }
+
+func TestReconcile(t *testing.T) {
+	t.Run("added removed changed unchanged", func(t *testing.T) {
+		actual := []setting{{"a", "1"}, {"b", "2"}, {"c", "3"}, {"d", "4"}}
+		desired := []setting{{"e", "5"}, {"c", "30"}, {"a", "1"}, {"f", "6"}}
+
+		got := hof.Reconcile(actual, desired, settingKey, settingEqual)
+		want := hof.Reconciliation[setting]{
+			Added:     []setting{{"e", "5"}, {"f", "6"}},
+			Removed:   []setting{{"b", "2"}, {"d", "4"}},
+			Changed:   []hof.Change[setting]{{Old: setting{"c", "3"}, New: setting{"c", "30"}}},
+			Unchanged: []setting{{"a", "1"}},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%+v\nwant:%+v", got, want)
+		}
+		if !got.HasChanges() {
+			t.Errorf("HasChanges() = false, want true")
+		}
+	})
+
+	t.Run("identical collections", func(t *testing.T) {
+		state := []setting{{"a", "1"}, {"b", "2"}}
+		got := hof.Reconcile(state, state, settingKey, settingEqual)
+
+		if got.HasChanges() || len(got.Unchanged) != 2 {
+			t.Errorf("got:%+v", got)
+		}
+	})
+
+	t.Run("from empty", func(t *testing.T) {
+		got := hof.Reconcile(nil, []setting{{"a", "1"}}, settingKey, settingEqual)
+
+		if len(got.Added) != 1 || len(got.Removed) != 0 {
+			t.Errorf("got:%+v", got)
+		}
+	})
+
+	t.Run("duplicate keys keep last", func(t *testing.T) {
+		old := []setting{{"a", "1"}, {"a", "2"}}
+		next := []setting{{"a", "2"}, {"b", "1"}, {"b", "3"}}
+		got := hof.Reconcile(old, next, settingKey, settingEqual)
+
+		want := hof.Reconciliation[setting]{
+			Added:     []setting{{"b", "3"}},
+			Unchanged: []setting{{"a", "2"}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%+v\nwant:%+v", got, want)
+		}
+	})
+}
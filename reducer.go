@@ -0,0 +1,169 @@
+package hof
+
+import "iter"
+
+// Composable Reducers
+
+// Reducer : Single-pass fold from elements of T to Out through an accumulator Acc
+//
+// Init returns a fresh accumulator for each run, Step has the same shape as
+// the callback given to Reduce, and Done turns the final accumulator into the
+// result.
+type Reducer[T, Acc, Out any] struct {
+	Init func() Acc
+	Step func(Acc, T) Acc
+	Done func(Acc) Out
+}
+
+// Apply : Run r over arr using Reduce
+func (r Reducer[T, Acc, Out]) Apply(arr []T) Out {
+	return r.Done(Reduce(arr, r.Step, r.Init()))
+}
+
+// ApplySeq : Run r over seq in a single pass
+func (r Reducer[T, Acc, Out]) ApplySeq(seq iter.Seq[T]) Out {
+	return r.Done(ReduceSeq(seq, r.Step, r.Init()))
+}
+
+// ReduceSeq : Reduce over a sequence
+func ReduceSeq[E any, T any](seq iter.Seq[E], fn func(T, E) T, init T) T {
+	acc := init
+	for v := range seq {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// NewReducer : Reducer from a Reduce-style callback and seed, finished by done
+func NewReducer[T, Acc, Out any](fn func(Acc, T) Acc, init Acc, done func(Acc) Out) Reducer[T, Acc, Out] {
+	return Reducer[T, Acc, Out]{
+		Init: func() Acc { return init },
+		Step: fn,
+		Done: done,
+	}
+}
+
+func identity[T any](v T) T { return v }
+
+// CountReducer : Count elements
+func CountReducer[T any]() Reducer[T, int, int] {
+	return NewReducer(func(n int, _ T) int { return n + 1 }, 0, identity[int])
+}
+
+// SumReducer : Add all numbers, like Sum
+func SumReducer[E Number]() Reducer[E, E, E] {
+	return NewReducer(func(sum, v E) E { return sum + v }, 0, identity[E])
+}
+
+// MinReducer : Smallest number, or zero when empty, like Min
+func MinReducer[E Number]() Reducer[E, Option[E], E] {
+	step := func(acc Option[E], v E) Option[E] {
+		if cur, ok := acc.Get(); ok && cur <= v {
+			return acc
+		}
+		return OptionOf(v)
+	}
+	return NewReducer(step, None[E](), func(acc Option[E]) E { return acc.OrElse(0) })
+}
+
+// MaxReducer : Largest number, or zero when empty, like Max
+func MaxReducer[E Number]() Reducer[E, Option[E], E] {
+	step := func(acc Option[E], v E) Option[E] {
+		if cur, ok := acc.Get(); ok && cur >= v {
+			return acc
+		}
+		return OptionOf(v)
+	}
+	return NewReducer(step, None[E](), func(acc Option[E]) E { return acc.OrElse(0) })
+}
+
+// AverageReducer : Mean as a float64, like Average
+func AverageReducer[E Number]() Reducer[E, Tuple2[E, int], float64] {
+	step := func(acc Tuple2[E, int], v E) Tuple2[E, int] {
+		return Tuple2[E, int]{acc.V1 + v, acc.V2 + 1}
+	}
+	done := func(acc Tuple2[E, int]) float64 { return float64(acc.V1) / float64(acc.V2) }
+	return NewReducer(step, Tuple2[E, int]{}, done)
+}
+
+// Tuples
+
+// Tuple2 : Two values of possibly different types
+type Tuple2[A, B any] struct {
+	V1 A
+	V2 B
+}
+
+// Tuple3 : Three values of possibly different types
+type Tuple3[A, B, C any] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+// Tuple4 : Four values of possibly different types
+type Tuple4[A, B, C, D any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+}
+
+// Tuple5 : Five values of possibly different types
+type Tuple5[A, B, C, D, E any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+	V5 E
+}
+
+// Combine2 : Run two reducers in one pass, producing both results
+func Combine2[T, A1, O1, A2, O2 any](r1 Reducer[T, A1, O1], r2 Reducer[T, A2, O2]) Reducer[T, Tuple2[A1, A2], Tuple2[O1, O2]] {
+	type acc = Tuple2[A1, A2]
+	return Reducer[T, acc, Tuple2[O1, O2]]{
+		Init: func() acc { return acc{r1.Init(), r2.Init()} },
+		Step: func(a acc, v T) acc { return acc{r1.Step(a.V1, v), r2.Step(a.V2, v)} },
+		Done: func(a acc) Tuple2[O1, O2] { return Tuple2[O1, O2]{r1.Done(a.V1), r2.Done(a.V2)} },
+	}
+}
+
+// Combine3 : Run three reducers in one pass, producing all results
+func Combine3[T, A1, O1, A2, O2, A3, O3 any](r1 Reducer[T, A1, O1], r2 Reducer[T, A2, O2], r3 Reducer[T, A3, O3]) Reducer[T, Tuple3[A1, A2, A3], Tuple3[O1, O2, O3]] {
+	type acc = Tuple3[A1, A2, A3]
+	return Reducer[T, acc, Tuple3[O1, O2, O3]]{
+		Init: func() acc { return acc{r1.Init(), r2.Init(), r3.Init()} },
+		Step: func(a acc, v T) acc { return acc{r1.Step(a.V1, v), r2.Step(a.V2, v), r3.Step(a.V3, v)} },
+		Done: func(a acc) Tuple3[O1, O2, O3] {
+			return Tuple3[O1, O2, O3]{r1.Done(a.V1), r2.Done(a.V2), r3.Done(a.V3)}
+		},
+	}
+}
+
+// Combine4 : Run four reducers in one pass, producing all results
+func Combine4[T, A1, O1, A2, O2, A3, O3, A4, O4 any](r1 Reducer[T, A1, O1], r2 Reducer[T, A2, O2], r3 Reducer[T, A3, O3], r4 Reducer[T, A4, O4]) Reducer[T, Tuple4[A1, A2, A3, A4], Tuple4[O1, O2, O3, O4]] {
+	type acc = Tuple4[A1, A2, A3, A4]
+	return Reducer[T, acc, Tuple4[O1, O2, O3, O4]]{
+		Init: func() acc { return acc{r1.Init(), r2.Init(), r3.Init(), r4.Init()} },
+		Step: func(a acc, v T) acc {
+			return acc{r1.Step(a.V1, v), r2.Step(a.V2, v), r3.Step(a.V3, v), r4.Step(a.V4, v)}
+		},
+		Done: func(a acc) Tuple4[O1, O2, O3, O4] {
+			return Tuple4[O1, O2, O3, O4]{r1.Done(a.V1), r2.Done(a.V2), r3.Done(a.V3), r4.Done(a.V4)}
+		},
+	}
+}
+
+// Combine5 : Run five reducers in one pass, producing all results
+func Combine5[T, A1, O1, A2, O2, A3, O3, A4, O4, A5, O5 any](r1 Reducer[T, A1, O1], r2 Reducer[T, A2, O2], r3 Reducer[T, A3, O3], r4 Reducer[T, A4, O4], r5 Reducer[T, A5, O5]) Reducer[T, Tuple5[A1, A2, A3, A4, A5], Tuple5[O1, O2, O3, O4, O5]] {
+	type acc = Tuple5[A1, A2, A3, A4, A5]
+	return Reducer[T, acc, Tuple5[O1, O2, O3, O4, O5]]{
+		Init: func() acc { return acc{r1.Init(), r2.Init(), r3.Init(), r4.Init(), r5.Init()} },
+		Step: func(a acc, v T) acc {
+			return acc{r1.Step(a.V1, v), r2.Step(a.V2, v), r3.Step(a.V3, v), r4.Step(a.V4, v), r5.Step(a.V5, v)}
+		},
+		Done: func(a acc) Tuple5[O1, O2, O3, O4, O5] {
+			return Tuple5[O1, O2, O3, O4, O5]{r1.Done(a.V1), r2.Done(a.V2), r3.Done(a.V3), r4.Done(a.V4), r5.Done(a.V5)}
+		},
+	}
+}
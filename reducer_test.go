@@ -0,0 +1,129 @@
+package hof_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/synctest"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestReducers(t *testing.T) {
+	nums := []int{4, -2, 9, 7, 0, 3}
+
+	t.Run("mirror existing helpers", func(t *testing.T) {
+		if got, want := hof.SumReducer[int]().Apply(nums), hof.Sum(nums); got != want {
+			t.Errorf("sum: got %v, want %v", got, want)
+		}
+		if got, want := hof.MinReducer[int]().Apply(nums), hof.Min(nums); got != want {
+			t.Errorf("min: got %v, want %v", got, want)
+		}
+		if got, want := hof.MaxReducer[int]().Apply(nums), hof.Max(nums); got != want {
+			t.Errorf("max: got %v, want %v", got, want)
+		}
+		if got, want := hof.AverageReducer[int]().Apply(nums), hof.Average(nums); !floatAlmostEqual(got, want, 1e-9) {
+			t.Errorf("average: got %v, want %v", got, want)
+		}
+		if got := hof.CountReducer[int]().Apply(nums); got != len(nums) {
+			t.Errorf("count: got %v, want %v", got, len(nums))
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		if got := hof.MinReducer[float64]().Apply(nil); got != 0 {
+			t.Errorf("min: got %v", got)
+		}
+		if got := hof.MaxReducer[float64]().Apply(nil); got != 0 {
+			t.Errorf("max: got %v", got)
+		}
+		if got := hof.AverageReducer[int]().Apply(nil); !math.IsNaN(got) {
+			t.Errorf("average: got %v, want NaN like Average", got)
+		}
+	})
+
+	t.Run("custom reducer", func(t *testing.T) {
+		join := hof.NewReducer(func(acc []string, s string) []string { return append(acc, s) }, nil,
+			func(acc []string) int { return len(acc) })
+		if got := join.ApplySeq(slices.Values([]string{"a", "b", "c"})); got != 3 {
+			t.Errorf("got %v", got)
+		}
+	})
+}
+
+func TestReduceSeq(t *testing.T) {
+	got := hof.ReduceSeq(hof.Range(1, 6, 1), func(acc, v int) int { return acc * v }, 1)
+	if got != 120 {
+		t.Errorf("got %v, want 120", got)
+	}
+}
+
+func TestCombine(t *testing.T) {
+	nums := []float64{2.5, -1, 8, 3.5}
+
+	t.Run("four stats in one pass", func(t *testing.T) {
+		pulled := 0
+		stats := hof.Combine4(hof.CountReducer[int](), hof.SumReducer[int](), hof.MinReducer[int](), hof.MaxReducer[int]())
+		got := stats.ApplySeq(countingSeq(10, &pulled))
+
+		want := hof.Tuple4[int, int, int, int]{V1: 10, V2: 45, V3: 0, V4: 9}
+		if got != want {
+			t.Errorf("got:%+v\nwant:%+v", got, want)
+		}
+		if pulled != 10 {
+			t.Errorf("pulled %d values, want 10", pulled)
+		}
+	})
+
+	t.Run("through Reduce", func(t *testing.T) {
+		r := hof.Combine2(hof.MinReducer[float64](), hof.MaxReducer[float64]())
+		got := r.Done(hof.Reduce(nums, r.Step, r.Init()))
+
+		if got.V1 != hof.Min(nums) || got.V2 != hof.Max(nums) {
+			t.Errorf("got:%+v", got)
+		}
+	})
+
+	t.Run("three and five", func(t *testing.T) {
+		three := hof.Combine3(hof.SumReducer[float64](), hof.AverageReducer[float64](), hof.CountReducer[float64]()).Apply(nums)
+		if three.V1 != 13 || !floatAlmostEqual(three.V2, 3.25, 1e-9) || three.V3 != 4 {
+			t.Errorf("got:%+v", three)
+		}
+
+		five := hof.Combine5(hof.CountReducer[float64](), hof.SumReducer[float64](), hof.MinReducer[float64](),
+			hof.MaxReducer[float64](), hof.AverageReducer[float64]()).Apply(nums)
+		want := hof.Tuple5[int, float64, float64, float64, float64]{V1: 4, V2: 13, V3: -1, V4: 8, V5: 3.25}
+		if !reflect.DeepEqual(five, want) {
+			t.Errorf("got:%+v\nwant:%+v", five, want)
+		}
+	})
+
+	t.Run("nested combine", func(t *testing.T) {
+		r := hof.Combine2(hof.CountReducer[int](), hof.Combine2(hof.MinReducer[int](), hof.MaxReducer[int]()))
+		got := r.Apply([]int{5, 1, 9})
+		if got.V1 != 3 || got.V2.V1 != 1 || got.V2.V2 != 9 {
+			t.Errorf("got:%+v", got)
+		}
+	})
+
+	t.Run("reusable across runs", func(t *testing.T) {
+		r := hof.Combine2(hof.CountReducer[int](), hof.SumReducer[int]())
+		first := r.Apply([]int{1, 2})
+		second := r.Apply([]int{10})
+		if first.V1 != 2 || first.V2 != 3 || second.V1 != 1 || second.V2 != 10 {
+			t.Errorf("first:%+v second:%+v", first, second)
+		}
+	})
+
+	t.Run("over a channel", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ch := hof.ToChan(context.Background(), hof.Range(1, 5, 1), 0)
+			got := hof.Combine2(hof.SumReducer[int](), hof.CountReducer[int]()).ApplySeq(hof.FromChan(ch))
+			if got.V1 != 10 || got.V2 != 4 {
+				t.Errorf("got:%+v", got)
+			}
+		})
+	})
+}
@@ -0,0 +1,49 @@
+package hof
+
+import (
+	"iter"
+	"slices"
+)
+
+// Running Accumulation
+
+// Scan : Lazily yield every intermediate accumulator, like Reduce without discarding them
+func Scan[E, T any](seq iter.Seq[E], fn func(T, E) T, init T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		acc := init
+		for v := range seq {
+			acc = fn(acc, v)
+			if !yield(acc) {
+				return
+			}
+		}
+	}
+}
+
+// runningFrom scans arr seeded with its first element, so min and max need no identity value.
+func runningFrom[E Number](arr []E, fn func(E, E) E) []E {
+	if len(arr) == 0 {
+		return nil
+	}
+	return slices.Collect(Scan(slices.Values(arr), fn, arr[0]))
+}
+
+// CumSum : Running totals
+func CumSum[E Number](arr []E) []E {
+	return slices.Collect(Scan(slices.Values(arr), func(acc, v E) E { return acc + v }, 0))
+}
+
+// CumProd : Running products
+func CumProd[E Number](arr []E) []E {
+	return slices.Collect(Scan(slices.Values(arr), func(acc, v E) E { return acc * v }, 1))
+}
+
+// RunningMax : Largest value seen so far at each position
+func RunningMax[E Number](arr []E) []E {
+	return runningFrom(arr, func(acc, v E) E { return max(acc, v) })
+}
+
+// RunningMin : Smallest value seen so far at each position
+func RunningMin[E Number](arr []E) []E {
+	return runningFrom(arr, func(acc, v E) E { return min(acc, v) })
+}
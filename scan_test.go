@@ -0,0 +1,92 @@
+package hof_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestScan(t *testing.T) {
+	t.Run("balance over time", func(t *testing.T) {
+		txns := []int{100, -30, -20, 50}
+		got := slices.Collect(hof.Scan(slices.Values(txns), func(bal, amt int) int { return bal + amt }, 10))
+		want := []int{110, 80, 60, 110}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("different accumulator type", func(t *testing.T) {
+		words := []string{"go", "is", "fun"}
+		got := slices.Collect(hof.Scan(slices.Values(words), func(acc int, w string) int { return acc + len(w) }, 0))
+		want := []int{2, 4, 7}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got := slices.Collect(hof.Scan(slices.Values([]int{}), func(acc, v int) int { return acc + v }, 5))
+		if got != nil {
+			t.Errorf("got:%v, want empty", got)
+		}
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		pulled := 0
+		got := slices.Collect(hof.Take(hof.Scan(countingSeq(100, &pulled), func(acc, v int) int { return acc + v }, 0), 3))
+
+		if !reflect.DeepEqual(got, []int{0, 1, 3}) || pulled != 3 {
+			t.Errorf("got:%v after pulling %d", got, pulled)
+		}
+	})
+}
+
+func TestCumulative(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5, 9, 2}
+
+	tests := []struct {
+		name string
+		fn   func([]int) []int
+		want []int
+	}{
+		{"cum sum", hof.CumSum[int], []int{3, 4, 8, 9, 14, 23, 25}},
+		{"cum prod", hof.CumProd[int], []int{3, 3, 12, 12, 60, 540, 1080}},
+		{"running max", hof.RunningMax[int], []int{3, 3, 4, 4, 5, 9, 9}},
+		{"running min", hof.RunningMin[int], []int{3, 1, 1, 1, 1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+			if len(tt.fn(nil)) != 0 {
+				t.Errorf("expected empty result for empty input")
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		got := hof.CumSum([]float64{0.5, 0.25, -1})
+		want := []float64{0.5, 0.75, -0.25}
+
+		if !slicesAlmostEqual(got, want, 1e-9) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("last value matches reducers", func(t *testing.T) {
+		if got := hof.CumSum(input); got[len(got)-1] != hof.Sum(input) {
+			t.Errorf("CumSum last = %v, Sum = %v", got[len(got)-1], hof.Sum(input))
+		}
+		if got := hof.RunningMax(input); got[len(got)-1] != hof.Max(input) {
+			t.Errorf("RunningMax last = %v, Max = %v", got[len(got)-1], hof.Max(input))
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package hof
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Set Algebra
+
+// Set : Unordered collection of unique values
+type Set[T comparable] map[T]struct{}
+
+// NewSet : Build a set from the given values
+func NewSet[T comparable](vals ...T) Set[T] {
+	s := make(Set[T], len(vals))
+	s.Add(vals...)
+	return s
+}
+
+// CollectSet : Build a set from a sequence
+func CollectSet[T comparable](seq iter.Seq[T]) Set[T] {
+	s := make(Set[T])
+	for v := range seq {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+// Add : Insert values into the set
+func (s Set[T]) Add(vals ...T) {
+	for _, v := range vals {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove : Delete values from the set
+func (s Set[T]) Remove(vals ...T) {
+	for _, v := range vals {
+		delete(s, v)
+	}
+}
+
+// Has : Report whether v is in the set
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len : Number of values in the set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// All : Iterate over the values in unspecified order
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+// Clone : Shallow copy of the set
+func (s Set[T]) Clone() Set[T] {
+	out := make(Set[T], len(s))
+	for v := range s {
+		out[v] = struct{}{}
+	}
+	return out
+}
+
+// Union : Values in either set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	out := s.Clone()
+	for v := range other {
+		out[v] = struct{}{}
+	}
+	return out
+}
+
+// Intersection : Values in both sets
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	out := make(Set[T])
+	for v := range small {
+		if large.Has(v) {
+			out[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Difference : Values in s but not in other
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	out := make(Set[T])
+	for v := range s {
+		if !other.Has(v) {
+			out[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// SymmetricDifference : Values in exactly one of the sets
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	out := s.Difference(other)
+	for v := range other {
+		if !s.Has(v) {
+			out[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// IsSubset : Report whether every value of s is in other
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset : Report whether every value of other is in s
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal : Report whether both sets hold the same values
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// SortedFunc : Values as a slice ordered by cmp
+func (s Set[T]) SortedFunc(cmp func(a, b T) int) []T {
+	out := slices.Collect(maps.Keys(s))
+	slices.SortFunc(out, cmp)
+	return out
+}
+
+// SortedSet : Values as an ascending slice
+func SortedSet[T cmp.Ordered](s Set[T]) []T {
+	return slices.Sorted(maps.Keys(s))
+}
+
+// Union : Unique values of a followed by those of b, in first-occurrence order
+func Union[T comparable](a, b []T) []T {
+	seen := make(Set[T])
+	var result []T
+	for _, arr := range [][]T{a, b} {
+		for _, v := range arr {
+			if !seen.Has(v) {
+				seen.Add(v)
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// Intersect : Unique values of a that also appear in b, in first-occurrence order
+func Intersect[T comparable](a, b []T) []T {
+	other := NewSet(b...)
+	seen := make(Set[T])
+	var result []T
+	for _, v := range a {
+		if other.Has(v) && !seen.Has(v) {
+			seen.Add(v)
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Difference : Unique values of a that do not appear in b, in first-occurrence order
+func Difference[T comparable](a, b []T) []T {
+	other := NewSet(b...)
+	seen := make(Set[T])
+	var result []T
+	for _, v := range a {
+		if !other.Has(v) && !seen.Has(v) {
+			seen.Add(v)
+			result = append(result, v)
+		}
+	}
+	return result
+}
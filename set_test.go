@@ -0,0 +1,157 @@
+package hof_test
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("add remove has", func(t *testing.T) {
+		s := hof.NewSet(1, 2, 3)
+		s.Add(3, 4)
+		s.Remove(1)
+
+		if s.Len() != 3 {
+			t.Errorf("Len() = %d, want 3", s.Len())
+		}
+		if s.Has(1) || !s.Has(4) {
+			t.Errorf("Has() mismatch for set %v", hof.SortedSet(s))
+		}
+	})
+
+	t.Run("collect from seq", func(t *testing.T) {
+		s := hof.CollectSet(hof.Filter([]int{1, 2, 3, 4, 4, 6}, func(n int) bool { return n%2 == 0 }))
+		got := hof.SortedSet(s)
+		want := []int{2, 4, 6}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("all iterates every value", func(t *testing.T) {
+		s := hof.NewSet("a", "b", "c")
+		got := slices.Sorted(s.All())
+		want := []string{"a", "b", "c"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("clone is independent", func(t *testing.T) {
+		s := hof.NewSet(1, 2)
+		c := s.Clone()
+		c.Add(3)
+
+		if s.Has(3) {
+			t.Errorf("mutating clone changed original: %v", hof.SortedSet(s))
+		}
+	})
+
+	t.Run("sorted func", func(t *testing.T) {
+		s := hof.NewSet(3, 1, 2)
+		got := s.SortedFunc(func(a, b int) int { return cmp.Compare(b, a) })
+		want := []int{3, 2, 1}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := hof.NewSet(1, 2, 3, 4)
+	b := hof.NewSet(3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  hof.Set[int]
+		want []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"intersection", a.Intersection(b), []int{3, 4}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"symmetric difference", a.SymmetricDifference(b), []int{1, 2, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hof.SortedSet(tt.got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("operands are not mutated", func(t *testing.T) {
+		if a.Len() != 4 || b.Len() != 3 {
+			t.Errorf("operands changed: a=%v b=%v", hof.SortedSet(a), hof.SortedSet(b))
+		}
+	})
+
+	t.Run("subset and superset", func(t *testing.T) {
+		sub := hof.NewSet(3, 4)
+		if !sub.IsSubset(a) || !sub.IsSubset(b) {
+			t.Errorf("expected %v to be a subset of both", hof.SortedSet(sub))
+		}
+		if a.IsSubset(b) {
+			t.Errorf("did not expect a to be a subset of b")
+		}
+		if !a.IsSuperset(sub) {
+			t.Errorf("expected a to be a superset of %v", hof.SortedSet(sub))
+		}
+		if !hof.NewSet[int]().IsSubset(a) {
+			t.Errorf("empty set should be a subset of every set")
+		}
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		if !a.Equal(hof.NewSet(4, 3, 2, 1)) {
+			t.Errorf("expected sets to be equal")
+		}
+		if a.Equal(b) {
+			t.Errorf("expected sets to differ")
+		}
+	})
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("first occurrence order", func(t *testing.T) {
+		got := hof.Union([]int{3, 1, 3, 2}, []int{2, 5, 1, 4})
+		want := []int{3, 1, 2, 5, 4}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		got := hof.Union([]string{}, nil)
+		if len(got) != 0 {
+			t.Errorf("Union() = %v, want empty", got)
+		}
+	})
+}
+
+func TestIntersect(t *testing.T) {
+	got := hof.Intersect([]string{"b", "a", "c", "a"}, []string{"a", "b", "d"})
+	want := []string{"b", "a"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	got := hof.Difference([]int{5, 1, 2, 5, 3}, []int{2})
+	want := []int{5, 1, 3}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+}
@@ -0,0 +1,254 @@
+package hof
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"iter"
+	"math"
+	"math/bits"
+)
+
+// Probabilistic Sketches
+//
+// Sketches hash values with a fixed, seedless function so that a sketch
+// serialized in one process can be restored and merged in another.
+
+var (
+	// ErrSketchMismatch : Sketches with different parameters cannot be merged
+	ErrSketchMismatch = errors.New("hof: sketch parameters do not match")
+	// ErrSketchEncoding : Serialized sketch is malformed
+	ErrSketchEncoding = errors.New("hof: malformed sketch encoding")
+)
+
+// sketchHash hashes data with FNV-1a followed by a 64-bit finalizer, since
+// FNV alone spreads short inputs poorly across the high bits.
+func sketchHash(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// maxBloomHashes bounds k; NewBloomFilter never needs more than about 40.
+const maxBloomHashes = 64
+
+// BloomFilter : Set membership with no false negatives and a bounded false-positive rate
+type BloomFilter struct {
+	bits []uint64
+	m    uint64
+	k    uint64
+}
+
+// NewBloomFilter : Size a filter for n expected items at false-positive rate p
+func NewBloomFilter(n uint64, p float64) *BloomFilter {
+	n = max(n, 1)
+	p = min(max(p, 1e-12), 0.5)
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	m = max(m, 64)
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	k = min(max(k, 1), maxBloomHashes)
+	return &BloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// locations derives k bit positions by double hashing.
+func (f *BloomFilter) locations(data []byte) iter.Seq[uint64] {
+	h := sketchHash(data)
+	h1, h2 := h&0xffffffff, h>>32|1
+	return func(yield func(uint64) bool) {
+		for i := range f.k {
+			if !yield((h1 + i*h2) % f.m) {
+				return
+			}
+		}
+	}
+}
+
+// Add : Record data in the filter
+func (f *BloomFilter) Add(data []byte) {
+	for loc := range f.locations(data) {
+		f.bits[loc/64] |= 1 << (loc % 64)
+	}
+}
+
+// Test : Report whether data may have been added
+func (f *BloomFilter) Test(data []byte) bool {
+	for loc := range f.locations(data) {
+		if f.bits[loc/64]&(1<<(loc%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestAndAdd : Record data, reporting whether it may have been added before
+func (f *BloomFilter) TestAndAdd(data []byte) bool {
+	present := true
+	for loc := range f.locations(data) {
+		word, mask := loc/64, uint64(1)<<(loc%64)
+		if f.bits[word]&mask == 0 {
+			present = false
+			f.bits[word] |= mask
+		}
+	}
+	return present
+}
+
+// Merge : Fold another filter of the same size into f
+func (f *BloomFilter) Merge(other *BloomFilter) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrSketchMismatch
+	}
+	for i, w := range other.bits {
+		f.bits[i] |= w
+	}
+	return nil
+}
+
+// MarshalBinary : Encode the filter
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 16, 16+8*len(f.bits))
+	binary.BigEndian.PutUint64(buf[0:], f.m)
+	binary.BigEndian.PutUint64(buf[8:], f.k)
+	for _, w := range f.bits {
+		buf = binary.BigEndian.AppendUint64(buf, w)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary : Restore a filter encoded by MarshalBinary
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < 16 {
+		return ErrSketchEncoding
+	}
+	m := binary.BigEndian.Uint64(data[0:])
+	k := binary.BigEndian.Uint64(data[8:])
+	// Bound m by the payload before rounding it up, so neither side can overflow.
+	payload := uint64(len(data) - 16)
+	if m == 0 || k == 0 || k > maxBloomHashes || payload%8 != 0 || m > 8*payload || (m+63)/64 != payload/8 {
+		return ErrSketchEncoding
+	}
+	f.m, f.k = m, k
+	f.bits = make([]uint64, payload/8)
+	for i := range f.bits {
+		f.bits[i] = binary.BigEndian.Uint64(data[16+8*i:])
+	}
+	return nil
+}
+
+// UniqueApprox : Lazily drop elements the filter has probably seen
+//
+// Memory stays bounded by the filter; a false positive drops an element that
+// was not actually seen. Reusing a filter carries dedup across batches.
+func UniqueApprox[T any](seq iter.Seq[T], keyFn func(T) []byte, filter *BloomFilter) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if filter.TestAndAdd(keyFn(v)) {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// HyperLogLog : Distinct-count estimator using 2^precision registers
+type HyperLogLog struct {
+	p         uint8
+	registers []uint8
+}
+
+// NewHyperLogLog : Build an estimator; precision is clamped to [4, 18]
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	precision = min(max(precision, 4), 18)
+	return &HyperLogLog{p: precision, registers: make([]uint8, 1<<precision)}
+}
+
+// Add : Record data in the estimator
+func (h *HyperLogLog) Add(data []byte) {
+	x := sketchHash(data)
+	idx := x >> (64 - h.p)
+	rank := uint8(bits.LeadingZeros64(x<<h.p|1<<(h.p-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// Count : Estimated number of distinct values added
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// Merge : Fold another estimator of the same precision into h
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if h.p != other.p {
+		return ErrSketchMismatch
+	}
+	for i, r := range other.registers {
+		h.registers[i] = max(h.registers[i], r)
+	}
+	return nil
+}
+
+// MarshalBinary : Encode the estimator
+func (h *HyperLogLog) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 1+len(h.registers))
+	buf = append(buf, h.p)
+	return append(buf, h.registers...), nil
+}
+
+// UnmarshalBinary : Restore an estimator encoded by MarshalBinary
+func (h *HyperLogLog) UnmarshalBinary(data []byte) error {
+	if len(data) < 1 || data[0] < 4 || data[0] > 18 || len(data)-1 != 1<<data[0] {
+		return ErrSketchEncoding
+	}
+	// A register holds a leading-zero count plus one, which cannot exceed 65-p.
+	for _, r := range data[1:] {
+		if r > 65-data[0] {
+			return ErrSketchEncoding
+		}
+	}
+	h.p = data[0]
+	h.registers = append([]uint8(nil), data[1:]...)
+	return nil
+}
+
+// CountDistinctApprox : Estimate distinct keys in a sequence with bounded memory
+func CountDistinctApprox[T any](seq iter.Seq[T], keyFn func(T) []byte, precision uint8) uint64 {
+	h := NewHyperLogLog(precision)
+	for v := range seq {
+		h.Add(keyFn(v))
+	}
+	return h.Count()
+}
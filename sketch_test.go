@@ -0,0 +1,236 @@
+package hof_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func intKey(n int) []byte { return strconv.AppendInt(nil, int64(n), 10) }
+
+func TestBloomFilter(t *testing.T) {
+	t.Run("no false negatives and bounded false positives", func(t *testing.T) {
+		const n = 10000
+		f := hof.NewBloomFilter(n, 0.01)
+		for i := range n {
+			f.Add(intKey(i))
+		}
+		for i := range n {
+			if !f.Test(intKey(i)) {
+				t.Fatalf("Test(%d) = false after Add", i)
+			}
+		}
+
+		falsePositives := 0
+		for i := n; i < 2*n; i++ {
+			if f.Test(intKey(i)) {
+				falsePositives++
+			}
+		}
+		if rate := float64(falsePositives) / n; rate > 0.02 {
+			t.Errorf("false-positive rate %.4f exceeds 0.02", rate)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		a := hof.NewBloomFilter(100, 0.01)
+		b := hof.NewBloomFilter(100, 0.01)
+		a.Add([]byte("left"))
+		b.Add([]byte("right"))
+
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Merge() error = %v", err)
+		}
+		if !a.Test([]byte("left")) || !a.Test([]byte("right")) {
+			t.Errorf("merged filter lost members")
+		}
+		if err := a.Merge(hof.NewBloomFilter(1000, 0.01)); !errors.Is(err, hof.ErrSketchMismatch) {
+			t.Errorf("Merge() of mismatched filter error = %v, want ErrSketchMismatch", err)
+		}
+	})
+
+	t.Run("binary round trip", func(t *testing.T) {
+		f := hof.NewBloomFilter(100, 0.01)
+		f.Add([]byte("kept"))
+		data, err := f.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() error = %v", err)
+		}
+
+		var restored hof.BloomFilter
+		if err := restored.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() error = %v", err)
+		}
+		if !restored.Test([]byte("kept")) {
+			t.Errorf("restored filter lost members")
+		}
+		if err := restored.UnmarshalBinary(data[:10]); !errors.Is(err, hof.ErrSketchEncoding) {
+			t.Errorf("UnmarshalBinary() of truncated data error = %v, want ErrSketchEncoding", err)
+		}
+	})
+
+	t.Run("malformed encodings", func(t *testing.T) {
+		header := func(m, k uint64, words int) []byte {
+			data := binary.BigEndian.AppendUint64(nil, m)
+			data = binary.BigEndian.AppendUint64(data, k)
+			return append(data, make([]byte, 8*words)...)
+		}
+		valid, _ := hof.NewBloomFilter(100, 0.01).MarshalBinary()
+
+		testCases := []struct {
+			name string
+			data []byte
+		}{
+			{"overflowing m", header(math.MaxUint64, 1, 0)},
+			{"m larger than payload", header(129, 1, 2)},
+			{"m smaller than payload", header(64, 1, 2)},
+			{"zero m", header(0, 1, 1)},
+			{"zero k", header(64, 0, 1)},
+			{"huge k", header(64, math.MaxUint64, 1)},
+			{"partial word", header(64, 1, 1)[:20]},
+			{"truncated payload", valid[:len(valid)-8]},
+			{"trailing bytes", append(valid, 0)},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				var f hof.BloomFilter
+				if err := f.UnmarshalBinary(tc.data); !errors.Is(err, hof.ErrSketchEncoding) {
+					t.Errorf("UnmarshalBinary() error = %v, want ErrSketchEncoding", err)
+				}
+			})
+		}
+
+		var f hof.BloomFilter
+		if err := f.UnmarshalBinary(header(100, 3, 2)); err != nil {
+			t.Fatalf("UnmarshalBinary() of a valid header error = %v", err)
+		}
+		f.Add([]byte("x"))
+		if !f.Test([]byte("x")) {
+			t.Errorf("decoded filter lost members")
+		}
+	})
+}
+
+func TestUniqueApprox(t *testing.T) {
+	input := []int{1, 2, 1, 3, 2, 4}
+	f := hof.NewBloomFilter(100, 0.001)
+	got := slices.Collect(hof.UniqueApprox(slices.Values(input), intKey, f))
+	want := []int{1, 2, 3, 4}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got:%v\nwant:%v", got, want)
+	}
+
+	next := slices.Collect(hof.UniqueApprox(slices.Values([]int{4, 5}), intKey, f))
+	if !slices.Equal(next, []int{5}) {
+		t.Errorf("reused filter should drop values from earlier batches, got %v", next)
+	}
+}
+
+func TestHyperLogLog(t *testing.T) {
+	const n = 100000
+
+	t.Run("estimate within error bound", func(t *testing.T) {
+		h := hof.NewHyperLogLog(14)
+		for i := range n {
+			h.Add(intKey(i))
+			h.Add(intKey(i))
+		}
+		if relErr := math.Abs(float64(h.Count())-n) / n; relErr > 0.03 {
+			t.Errorf("Count() = %d, relative error %.4f exceeds 0.03", h.Count(), relErr)
+		}
+	})
+
+	t.Run("small cardinalities", func(t *testing.T) {
+		h := hof.NewHyperLogLog(14)
+		for i := range 10 {
+			h.Add(intKey(i))
+		}
+		if got := h.Count(); got != 10 {
+			t.Errorf("Count() = %d, want 10", got)
+		}
+	})
+
+	t.Run("merge matches single sketch", func(t *testing.T) {
+		whole := hof.NewHyperLogLog(12)
+		a, b := hof.NewHyperLogLog(12), hof.NewHyperLogLog(12)
+		for i := range n {
+			whole.Add(intKey(i))
+			if i%2 == 0 {
+				a.Add(intKey(i))
+			} else {
+				b.Add(intKey(i))
+			}
+		}
+
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Merge() error = %v", err)
+		}
+		if a.Count() != whole.Count() {
+			t.Errorf("merged Count() = %d, want %d", a.Count(), whole.Count())
+		}
+		if err := a.Merge(hof.NewHyperLogLog(10)); !errors.Is(err, hof.ErrSketchMismatch) {
+			t.Errorf("Merge() of mismatched precision error = %v, want ErrSketchMismatch", err)
+		}
+	})
+
+	t.Run("binary round trip", func(t *testing.T) {
+		h := hof.NewHyperLogLog(10)
+		for i := range 1000 {
+			h.Add(intKey(i))
+		}
+		data, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() error = %v", err)
+		}
+
+		var restored hof.HyperLogLog
+		if err := restored.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() error = %v", err)
+		}
+		if restored.Count() != h.Count() {
+			t.Errorf("restored Count() = %d, want %d", restored.Count(), h.Count())
+		}
+		if err := restored.UnmarshalBinary([]byte{10, 0}); !errors.Is(err, hof.ErrSketchEncoding) {
+			t.Errorf("UnmarshalBinary() of truncated data error = %v, want ErrSketchEncoding", err)
+		}
+	})
+
+	t.Run("register out of range", func(t *testing.T) {
+		const p = 4
+		data := make([]byte, 1+1<<p)
+		data[0] = p
+		for i := 1; i < len(data); i++ {
+			data[i] = 65 - p
+		}
+
+		var h hof.HyperLogLog
+		if err := h.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() of maximal registers error = %v", err)
+		}
+		data[1] = 66 - p
+		if err := h.UnmarshalBinary(data); !errors.Is(err, hof.ErrSketchEncoding) {
+			t.Errorf("UnmarshalBinary() of register %d error = %v, want ErrSketchEncoding", data[1], err)
+		}
+		for i := 1; i < len(data); i++ {
+			data[i] = 200
+		}
+		if err := h.UnmarshalBinary(data); !errors.Is(err, hof.ErrSketchEncoding) {
+			t.Errorf("UnmarshalBinary() of register 200 error = %v, want ErrSketchEncoding", err)
+		}
+	})
+}
+
+func TestCountDistinctApprox(t *testing.T) {
+	input := []int{1, 2, 3, 1, 2, 3, 4}
+	got := hof.CountDistinctApprox(slices.Values(input), intKey, 12)
+
+	if got != 4 {
+		t.Errorf("CountDistinctApprox() = %d, want 4", got)
+	}
+}
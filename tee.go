@@ -0,0 +1,184 @@
+package hof
+
+import (
+	"iter"
+	"sync"
+)
+
+// Sharing One Pass
+
+type tee[T any] struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	seq       iter.Seq[T]
+	next      func() (T, bool)
+	stop      func()
+	pulling   bool
+	exhausted bool
+
+	buf   []T   // values not yet read by every consumer
+	base  int   // absolute index of buf[0]
+	limit int   // maximum len(buf), or 0 for no limit
+	pos   []int // absolute index of each consumer's next value
+	done  []bool
+}
+
+// trim drops values every active consumer has read. Callers hold mu.
+func (t *tee[T]) trim() {
+	low := -1
+	for i, p := range t.pos {
+		if !t.done[i] && (low < 0 || p < low) {
+			low = p
+		}
+	}
+	if low < 0 {
+		low = t.base + len(t.buf)
+	}
+	if drop := low - t.base; drop > 0 {
+		clear(t.buf[:drop])
+		t.buf = t.buf[drop:]
+		t.base = low
+		t.cond.Broadcast()
+	}
+}
+
+// release stops upstream once every consumer is done and no pull is in flight. Callers hold mu.
+func (t *tee[T]) release() {
+	if t.pulling {
+		return
+	}
+	for _, d := range t.done {
+		if !d {
+			return
+		}
+	}
+	t.exhausted = true
+	if t.stop != nil {
+		t.stop()
+		t.stop = nil
+	}
+}
+
+// finish retires consumer i. Callers hold mu.
+func (t *tee[T]) finish(i int) {
+	t.done[i] = true
+	t.trim()
+	t.release()
+}
+
+// outside runs fn without holding mu, retaking it even if fn panics.
+func (t *tee[T]) outside(fn func()) {
+	t.mu.Unlock()
+	defer t.mu.Lock()
+	fn()
+}
+
+func (t *tee[T]) consumer(i int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.mu.Lock()
+		pulling := false
+		defer func() {
+			if pulling {
+				// Upstream panicked mid-pull, so nothing more can be read from it.
+				t.pulling, t.exhausted = false, true
+				t.cond.Broadcast()
+			}
+			t.finish(i)
+			t.mu.Unlock()
+		}()
+
+		for !t.done[i] {
+			if idx := t.pos[i] - t.base; idx < len(t.buf) {
+				v := t.buf[idx]
+				t.pos[i]++
+				t.trim()
+				ok := false
+				t.outside(func() { ok = yield(v) })
+				if !ok {
+					return
+				}
+				continue
+			}
+			if t.exhausted {
+				return
+			}
+			if t.pulling || (t.limit > 0 && len(t.buf) >= t.limit) {
+				t.cond.Wait()
+				continue
+			}
+
+			if t.next == nil {
+				t.next, t.stop = iter.Pull(t.seq)
+			}
+			var v T
+			ok := false
+			pulling, t.pulling = true, true
+			t.outside(func() { v, ok = t.next() })
+			pulling, t.pulling = false, false
+			if ok {
+				t.buf = append(t.buf, v)
+			} else {
+				t.exhausted = true
+			}
+			t.cond.Broadcast()
+		}
+	}
+}
+
+// Tee : Split seq into n sequences that each see every element, pulling upstream once
+//
+// Values are buffered until every consumer has read them; buffer caps that
+// backlog (0 means unbounded). With a bounded buffer, consumers that drift
+// more than buffer elements apart must run in separate goroutines, otherwise
+// the one ahead waits forever. Each returned sequence can be iterated once.
+//
+// Upstream is released once every sequence has been drained or broken out
+// of. Call stop when some may never be ranged over; it ends any iteration
+// still in progress and is safe to call more than once.
+func Tee[T any](seq iter.Seq[T], n int, buffer int) (seqs []iter.Seq[T], stop func()) {
+	n = max(n, 0)
+	t := &tee[T]{
+		seq:   seq,
+		limit: max(buffer, 0),
+		pos:   make([]int, n),
+		done:  make([]bool, n),
+	}
+	t.cond = sync.NewCond(&t.mu)
+
+	seqs = make([]iter.Seq[T], n)
+	for i := range seqs {
+		seqs[i] = t.consumer(i)
+	}
+	stop = func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		for i := range t.done {
+			t.done[i] = true
+		}
+		t.trim()
+		t.release()
+		t.cond.Broadcast()
+	}
+	return seqs, stop
+}
+
+// Fanout : Feed each element of seq to several aggregators in a single pass, returning their results in order
+func Fanout[T any](seq iter.Seq[T], aggs ...Aggregator[T]) []any {
+	steps := make([]func(T), len(aggs))
+	results := make([]func() any, len(aggs))
+	for i, agg := range aggs {
+		steps[i], results[i] = agg()
+	}
+	for v := range seq {
+		for _, step := range steps {
+			step(v)
+		}
+	}
+
+	out := make([]any, len(results))
+	for i, result := range results {
+		out[i] = result()
+	}
+	return out
+}
@@ -0,0 +1,183 @@
+package hof_test
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"testing/synctest"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestTee(t *testing.T) {
+	t.Run("sum and max over a one-shot channel", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ch := hof.ToChan(context.Background(), hof.Range(1, 101, 1), 0)
+			seqs, stop := hof.Tee(hof.FromChan(ch), 2, 4)
+			defer stop()
+
+			var sum, largest int
+			var wg sync.WaitGroup
+			wg.Go(func() { sum = hof.Sum(slices.Collect(seqs[0])) })
+			wg.Go(func() { largest = hof.Max(slices.Collect(seqs[1])) })
+			wg.Wait()
+
+			if sum != 5050 || largest != 100 {
+				t.Errorf("sum = %d, max = %d, want 5050 and 100", sum, largest)
+			}
+		})
+	})
+
+	t.Run("pulls upstream once", func(t *testing.T) {
+		pulled := 0
+		seqs, stop := hof.Tee(countingSeq(5, &pulled), 3, 0)
+		defer stop()
+
+		for i, seq := range seqs {
+			if got := slices.Collect(seq); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+				t.Errorf("consumer %d got:%v", i, got)
+			}
+		}
+		if pulled != 5 {
+			t.Errorf("pulled %d values, want 5", pulled)
+		}
+	})
+
+	t.Run("early break releases the buffer", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			seqs, stop := hof.Tee(hof.Range(0, 50, 1), 2, 1)
+			defer stop()
+
+			var full []int
+			var wg sync.WaitGroup
+			wg.Go(func() {
+				for v := range seqs[0] {
+					if v == 2 {
+						break
+					}
+				}
+			})
+			wg.Go(func() { full = slices.Collect(seqs[1]) })
+			wg.Wait()
+
+			if len(full) != 50 {
+				t.Errorf("remaining consumer saw %d values, want 50", len(full))
+			}
+		})
+	})
+
+	t.Run("all consumers break stops upstream", func(t *testing.T) {
+		pulled := 0
+		seqs, _ := hof.Tee(countingSeq(100, &pulled), 2, 0)
+
+		for range seqs[0] {
+			break
+		}
+		for range seqs[1] {
+			break
+		}
+		if pulled != 1 {
+			t.Errorf("pulled %d values, want 1", pulled)
+		}
+	})
+
+	t.Run("stop releases upstream when a consumer is never used", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			for range 100 {
+				seqs, stop := hof.Tee(hof.Range(0, 10, 1), 2, 0)
+				for range seqs[0] {
+					break
+				}
+				stop()
+			}
+		})
+	})
+
+	t.Run("stop ends iteration in progress", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			seqs, stop := hof.Tee(hof.Iterate(0, func(n int) int { return n + 1 }), 2, 1)
+
+			hold := make(chan struct{})
+			var wg sync.WaitGroup
+			wg.Go(func() {
+				for range seqs[0] {
+				}
+			})
+			wg.Go(func() {
+				for range seqs[1] {
+					<-hold
+				}
+			})
+			// seqs[0] now waits on the full buffer and seqs[1] inside its loop body.
+			synctest.Wait()
+			stop()
+			stop()
+			close(hold)
+			wg.Wait()
+		})
+	})
+
+	t.Run("panicking consumer leaves the tee usable", func(t *testing.T) {
+		seqs, stop := hof.Tee(hof.Range(0, 3, 1), 2, 0)
+		defer stop()
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected the consumer panic to propagate")
+				}
+			}()
+			for range seqs[0] {
+				panic("boom")
+			}
+		}()
+
+		if got := slices.Collect(seqs[1]); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+			t.Errorf("remaining consumer got:%v", got)
+		}
+	})
+
+	t.Run("zero consumers", func(t *testing.T) {
+		if got, _ := hof.Tee(hof.Range(0, 3, 1), 0, 0); len(got) != 0 {
+			t.Errorf("got %d sequences", len(got))
+		}
+	})
+}
+
+func TestFanout(t *testing.T) {
+	t.Run("several aggregators in one pass", func(t *testing.T) {
+		pulled := 0
+		id := func(n int) int { return n }
+		got := hof.Fanout(countingSeq(10, &pulled),
+			hof.CountAgg[int](), hof.SumAgg(id), hof.MinAgg(id), hof.MaxAgg(id))
+
+		want := []any{10, 45, 0, 9}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+		if pulled != 10 {
+			t.Errorf("pulled %d values, want 10", pulled)
+		}
+	})
+
+	t.Run("over a channel", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ch := hof.ToChan(context.Background(), slices.Values(sales), 0)
+			amount := func(s sale) int { return s.Amount }
+			got := hof.Fanout(hof.FromChan(ch), hof.SumAgg(amount), hof.CountAgg[sale]())
+
+			total := hof.Reduce(sales, func(acc int, s sale) int { return acc + s.Amount }, 0)
+			if got[0] != total || got[1] != len(sales) {
+				t.Errorf("got:%v, want [%v %d]", got, total, len(sales))
+			}
+		})
+	})
+
+	t.Run("no aggregators", func(t *testing.T) {
+		if got := hof.Fanout(hof.Range(0, 3, 1)); len(got) != 0 {
+			t.Errorf("got:%v", got)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package hof
+
+import (
+	"context"
+	"iter"
+)
+
+// Transducers
+
+// Sink : Push-based consumer at the end of a transducer stack
+//
+// Push returns false once the sink wants no more values. Complete is called
+// exactly once when the run ends, whether the input ran out or a Push
+// returned false, so stateful stages can flush what they hold; pushes made
+// while completing a stopped sink are ignored.
+//
+// Stopped is optional and reports that the sink wants no values at all, so
+// runners can skip pulling the first one; stages forward it from the sink
+// they wrap.
+type Sink[T any] struct {
+	Push     func(T) bool
+	Complete func()
+	Stopped  func() bool
+}
+
+// stopped reports whether s declined values before any were pushed.
+func (s Sink[T]) stopped() bool {
+	return s.Stopped != nil && s.Stopped()
+}
+
+// Transducer : Transformation from a stream of A to a stream of B, independent of source and destination
+type Transducer[A, B any] func(Sink[B]) Sink[A]
+
+// TMap : Transform every value with fn, like Map
+func TMap[A, B any](fn func(A) B) Transducer[A, B] {
+	return func(next Sink[B]) Sink[A] {
+		return Sink[A]{
+			Push:     func(v A) bool { return next.Push(fn(v)) },
+			Complete: next.Complete,
+			Stopped:  next.stopped,
+		}
+	}
+}
+
+// TFilter : Keep values that satisfy fn, like Filter
+func TFilter[T any](fn func(T) bool) Transducer[T, T] {
+	return func(next Sink[T]) Sink[T] {
+		return Sink[T]{
+			Push:     func(v T) bool { return !fn(v) || next.Push(v) },
+			Complete: next.Complete,
+			Stopped:  next.stopped,
+		}
+	}
+}
+
+// TTake : Pass the first n values, then stop the run; like Take, nothing is pulled when n <= 0
+func TTake[T any](n int) Transducer[T, T] {
+	return func(next Sink[T]) Sink[T] {
+		taken := 0
+		return Sink[T]{
+			Push: func(v T) bool {
+				if taken >= n {
+					return false
+				}
+				taken++
+				return next.Push(v) && taken < n
+			},
+			Complete: next.Complete,
+			Stopped:  func() bool { return taken >= n || next.stopped() },
+		}
+	}
+}
+
+// TDedupe : Drop values equal to the one just before, like DedupConsecutive
+func TDedupe[T comparable]() Transducer[T, T] {
+	return func(next Sink[T]) Sink[T] {
+		var prev T
+		seen := false
+		return Sink[T]{
+			Push: func(v T) bool {
+				if seen && v == prev {
+					return true
+				}
+				prev, seen = v, true
+				return next.Push(v)
+			},
+			Complete: next.Complete,
+			Stopped:  next.stopped,
+		}
+	}
+}
+
+// TComp : Chain two transducers; values flow through first, then second
+func TComp[A, B, C any](first Transducer[A, B], second Transducer[B, C]) Transducer[A, C] {
+	return func(next Sink[C]) Sink[A] {
+		return first(second(next))
+	}
+}
+
+// feed pushes arr into sink through ReduceWhile, leaving the rest unvisited once the sink stops.
+func feed[T any](arr []T, sink Sink[T]) {
+	if !sink.stopped() {
+		ReduceWhile(arr, func(_ struct{}, v T) (struct{}, bool) { return struct{}{}, sink.Push(v) }, struct{}{})
+	}
+	sink.Complete()
+}
+
+// Transduce : Apply xf to arr and fold the output with r in a single pass
+func Transduce[A, B, Acc, Out any](arr []A, xf Transducer[A, B], r Reducer[B, Acc, Out]) Out {
+	acc := r.Init()
+	feed(arr, xf(Sink[B]{
+		Push:     func(v B) bool { acc = r.Step(acc, v); return true },
+		Complete: func() {},
+	}))
+	return r.Done(acc)
+}
+
+// TransduceSlice : Apply xf to arr, collecting the output
+func TransduceSlice[A, B any](arr []A, xf Transducer[A, B]) []B {
+	var out []B
+	feed(arr, xf(Sink[B]{
+		Push:     func(v B) bool { out = append(out, v); return true },
+		Complete: func() {},
+	}))
+	return out
+}
+
+// TransduceSeq : Lazily apply xf to seq
+func TransduceSeq[A, B any](seq iter.Seq[A], xf Transducer[A, B]) iter.Seq[B] {
+	return func(yield func(B) bool) {
+		stopped := false
+		sink := xf(Sink[B]{
+			Push: func(v B) bool {
+				stopped = stopped || !yield(v)
+				return !stopped
+			},
+			Complete: func() {},
+		})
+		if !sink.stopped() {
+			for v := range seq {
+				if !sink.Push(v) {
+					break
+				}
+			}
+		}
+		sink.Complete()
+	}
+}
+
+// TransduceChan : Apply xf to values received from in, sending the output on a channel
+//
+// The output closes once in closes, xf stops the run or ctx is cancelled.
+// Stopping early leaves the remaining values in in.
+func TransduceChan[A, B any](ctx context.Context, in <-chan A, xf Transducer[A, B], buffer int) <-chan B {
+	out := make(chan B, max(buffer, 0))
+	go func() {
+		defer close(out)
+		sink := xf(Sink[B]{
+			Push: func(v B) bool {
+				if ctx.Err() != nil {
+					return false
+				}
+				select {
+				case out <- v:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			},
+			Complete: func() {},
+		})
+		defer sink.Complete()
+		for !sink.stopped() {
+			select {
+			case v, ok := <-in:
+				if !ok || !sink.Push(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,229 @@
+package hof_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+	"testing/synctest"
+
+	"github.com/suryanshu-09/hof"
+)
+
+// pairs groups values two at a time, flushing a trailing single on completion.
+func pairs[T any](completed *int) hof.Transducer[T, []T] {
+	return func(next hof.Sink[[]T]) hof.Sink[T] {
+		var held []T
+		return hof.Sink[T]{
+			Push: func(v T) bool {
+				held = append(held, v)
+				if len(held) < 2 {
+					return true
+				}
+				batch := held
+				held = nil
+				return next.Push(batch)
+			},
+			Complete: func() {
+				*completed++
+				if len(held) > 0 {
+					next.Push(held)
+				}
+				next.Complete()
+			},
+		}
+	}
+}
+
+func TestTransducers(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	label := func(n int) string { return "n" + strconv.Itoa(n) }
+
+	t.Run("same result as Map and Filter", func(t *testing.T) {
+		nums := slices.Collect(hof.Range(0, 10, 1))
+		xf := hof.TComp(hof.TFilter(even), hof.TMap(label))
+
+		got := hof.TransduceSlice(nums, xf)
+		want := slices.Collect(hof.Map(slices.Collect(hof.Filter(nums, even)), label))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("dedupe and take", func(t *testing.T) {
+		xf := hof.TComp(hof.TDedupe[int](), hof.TTake[int](3))
+		got := hof.TransduceSlice([]int{1, 1, 2, 2, 2, 3, 1, 1, 4}, xf)
+
+		if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("take zero", func(t *testing.T) {
+		if got := hof.TransduceSlice([]int{1, 2}, hof.TTake[int](0)); len(got) != 0 {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("take zero pulls nothing", func(t *testing.T) {
+		pulled := 0
+		xf := hof.TComp(hof.TMap(func(n int) int { return n * 2 }), hof.TTake[int](0))
+		got := slices.Collect(hof.TransduceSeq(countingSeq(10, &pulled), xf))
+
+		if len(got) != 0 || pulled != 0 {
+			t.Errorf("got:%v after pulling %d values, want none", got, pulled)
+		}
+		if pulled = 0; slices.Collect(hof.Take(countingSeq(10, &pulled), 0)) != nil || pulled != 0 {
+			t.Errorf("Take pulled %d values", pulled)
+		}
+	})
+
+	t.Run("composition order", func(t *testing.T) {
+		double := hof.TMap(func(n int) int { return n * 2 })
+		inc := hof.TMap(func(n int) int { return n + 1 })
+
+		if got := hof.TransduceSlice([]int{1, 2}, hof.TComp(double, inc)); !reflect.DeepEqual(got, []int{3, 5}) {
+			t.Errorf("double then inc got:%v", got)
+		}
+		if got := hof.TransduceSlice([]int{1, 2}, hof.TComp(inc, double)); !reflect.DeepEqual(got, []int{4, 6}) {
+			t.Errorf("inc then double got:%v", got)
+		}
+	})
+}
+
+func TestTransduceRunners(t *testing.T) {
+	xf := hof.TComp(hof.TFilter(func(n int) bool { return n%3 != 0 }), hof.TTake[int](4))
+	want := []int{1, 2, 4, 5}
+
+	t.Run("slice", func(t *testing.T) {
+		if got := hof.TransduceSlice(slices.Collect(hof.Range(0, 100, 1)), xf); !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v", got)
+		}
+	})
+
+	t.Run("slice stops visiting early", func(t *testing.T) {
+		visited := 0
+		count := hof.TMap(func(v struct{}) struct{} { visited++; return v })
+		// Zero-size elements make an effectively endless slice without allocating.
+		endless := make([]struct{}, math.MaxInt)
+
+		got := hof.TransduceSlice(endless, hof.TComp(count, hof.TTake[struct{}](1)))
+		if len(got) != 1 || visited != 1 {
+			t.Errorf("got %d values after visiting %d elements, want 1 and 1", len(got), visited)
+		}
+		if r := hof.Transduce(endless, hof.TTake[struct{}](2), hof.CountReducer[struct{}]()); r != 2 {
+			t.Errorf("Transduce counted %d, want 2", r)
+		}
+	})
+
+	t.Run("seq stops pulling early", func(t *testing.T) {
+		pulled := 0
+		got := slices.Collect(hof.TransduceSeq(countingSeq(100, &pulled), xf))
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v", got)
+		}
+		if pulled != 6 {
+			t.Errorf("pulled %d values, want 6", pulled)
+		}
+	})
+
+	t.Run("seq consumer break", func(t *testing.T) {
+		completed := 0
+		var got [][]int
+		for batch := range hof.TransduceSeq(hof.Range(0, 10, 1), pairs[int](&completed)) {
+			got = append(got, batch)
+			break
+		}
+		if !reflect.DeepEqual(got, [][]int{{0, 1}}) || completed != 1 {
+			t.Errorf("got:%v, completed %d times", got, completed)
+		}
+	})
+
+	t.Run("channel", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			in := hof.ToChan(ctx, hof.Range(0, 100, 1), 0)
+
+			if got := slices.Collect(hof.FromChan(hof.TransduceChan(ctx, in, xf, 0))); !reflect.DeepEqual(got, want) {
+				t.Errorf("got:%v", got)
+			}
+		})
+	})
+
+	t.Run("channel cancel", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			in := hof.ToChan(ctx, hof.Iterate(0, func(n int) int { return n + 1 }), 0)
+			out := hof.TransduceChan(ctx, in, hof.TMap(strconv.Itoa), 0)
+
+			<-out
+			cancel()
+		})
+	})
+
+	t.Run("channel take zero reads nothing", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			in := make(chan int, 2)
+			in <- 1
+			in <- 2
+			got := slices.Collect(hof.FromChan(hof.TransduceChan(context.Background(), in, hof.TTake[int](0), 0)))
+
+			if len(got) != 0 || len(in) != 2 {
+				t.Errorf("got:%v, %d values left in input, want 2", got, len(in))
+			}
+		})
+	})
+
+	t.Run("into a reducer", func(t *testing.T) {
+		stats := hof.Combine2(hof.CountReducer[int](), hof.SumReducer[int]())
+		got := hof.Transduce(slices.Collect(hof.Range(0, 100, 1)), xf, stats)
+
+		if got.V1 != 4 || got.V2 != 12 {
+			t.Errorf("got:%+v", got)
+		}
+	})
+}
+
+func TestTransduceCompletion(t *testing.T) {
+	t.Run("flushes held values", func(t *testing.T) {
+		completed := 0
+		got := hof.TransduceSlice([]int{1, 2, 3, 4, 5}, pairs[int](&completed))
+
+		if !reflect.DeepEqual(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+			t.Errorf("got:%v", got)
+		}
+		if completed != 1 {
+			t.Errorf("completed %d times, want 1", completed)
+		}
+	})
+
+	t.Run("after early termination", func(t *testing.T) {
+		completed := 0
+		xf := hof.TComp(hof.TTake[int](3), pairs[int](&completed))
+		got := slices.Collect(hof.TransduceSeq(hof.Range(0, 10, 1), xf))
+
+		if !reflect.DeepEqual(got, [][]int{{0, 1}, {2}}) {
+			t.Errorf("got:%v", got)
+		}
+		if completed != 1 {
+			t.Errorf("completed %d times, want 1", completed)
+		}
+	})
+
+	t.Run("channel flush", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			completed := 0
+			ctx := context.Background()
+			in := hof.ToChan(ctx, hof.Range(0, 3, 1), 0)
+			got := slices.Collect(hof.FromChan(hof.TransduceChan(ctx, in, pairs[int](&completed), 0)))
+
+			if !reflect.DeepEqual(got, [][]int{{0, 1}, {2}}) {
+				t.Errorf("got:%v", got)
+			}
+		})
+	})
+}
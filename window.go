@@ -0,0 +1,85 @@
+package hof
+
+import (
+	"iter"
+	"slices"
+)
+
+// Sliding Windows
+
+// Windows : Overlapping windows of size elements, starting every step elements
+//
+// Windows are read-only views into arr; their capacity is capped so appending
+// to one reallocates instead of overwriting arr. Only full windows are returned.
+func Windows[T any](arr []T, size, step int) [][]T {
+	if size <= 0 || step <= 0 {
+		return nil
+	}
+	var windows [][]T
+	for i := 0; i+size <= len(arr); i += step {
+		windows = append(windows, arr[i:i+size:i+size])
+	}
+	return windows
+}
+
+// Pairwise : Consecutive pairs (arr[0], arr[1]), (arr[1], arr[2]), ...
+func Pairwise[T any](arr []T) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		for i := 1; i < len(arr); i++ {
+			if !yield(arr[i-1], arr[i]) {
+				return
+			}
+		}
+	}
+}
+
+// WindowsSeq : Lazily yield full sliding windows over seq
+//
+// Every window is a view into one shared ring buffer and is only valid until
+// the next iteration; use WindowsSeqCopy to retain windows.
+func WindowsSeq[T any](seq iter.Seq[T], size, step int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 || step <= 0 {
+			return
+		}
+		// Each element is written twice, size apart, so the latest window is
+		// always the contiguous slice buf[pos:pos+size].
+		buf := make([]T, 2*size)
+		pos, n := 0, 0
+		for v := range seq {
+			buf[pos], buf[pos+size] = v, v
+			pos = (pos + 1) % size
+			n++
+			if n >= size && (n-size)%step == 0 {
+				if !yield(buf[pos : pos+size : pos+size]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// WindowsSeqCopy : Like WindowsSeq, but every window is a fresh slice safe to retain
+func WindowsSeqCopy[T any](seq iter.Seq[T], size, step int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for w := range WindowsSeq(seq, size, step) {
+			if !yield(slices.Clone(w)) {
+				return
+			}
+		}
+	}
+}
+
+// PairwiseSeq : Lazily yield consecutive pairs of seq
+func PairwiseSeq[T any](seq iter.Seq[T]) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		var prev T
+		first := true
+		for v := range seq {
+			if !first && !yield(prev, v) {
+				return
+			}
+			prev, first = v, false
+		}
+	}
+}
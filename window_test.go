@@ -0,0 +1,121 @@
+package hof_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/suryanshu-09/hof"
+)
+
+func TestWindows(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+
+	tests := []struct {
+		name       string
+		size, step int
+		want       [][]int
+	}{
+		{"size three step one", 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}}},
+		{"size two step two", 2, 2, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"step larger than size", 2, 3, [][]int{{1, 2}, {4, 5}}},
+		{"size larger than input", 7, 1, nil},
+		{"zero size", 0, 1, nil},
+		{"zero step", 2, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hof.Windows(input, tt.size, tt.step)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:%v\nwant:%v", got, tt.want)
+			}
+
+			seq := slices.Collect(hof.WindowsSeqCopy(slices.Values(input), tt.size, tt.step))
+			if !reflect.DeepEqual(seq, tt.want) {
+				t.Errorf("WindowsSeqCopy got:%v\nwant:%v", seq, tt.want)
+			}
+		})
+	}
+
+	t.Run("append does not overwrite input", func(t *testing.T) {
+		arr := []int{1, 2, 3, 4}
+		w := hof.Windows(arr, 2, 1)
+		_ = append(w[0], 99)
+
+		if !reflect.DeepEqual(arr, []int{1, 2, 3, 4}) {
+			t.Errorf("appending to a window changed the input: %v", arr)
+		}
+	})
+}
+
+func TestWindowsSeq(t *testing.T) {
+	t.Run("reuses buffer", func(t *testing.T) {
+		var got [][]int
+		var first []int
+		for w := range hof.WindowsSeq(slices.Values([]int{1, 2, 3, 4, 5}), 3, 1) {
+			if first == nil {
+				first = w
+			}
+			got = append(got, slices.Clone(w))
+		}
+
+		want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+		if reflect.DeepEqual(first, want[0]) {
+			t.Errorf("expected retained window to be overwritten by later windows")
+		}
+	})
+
+	t.Run("allocates once", func(t *testing.T) {
+		input := slices.Collect(hof.Range(0, 1000, 1))
+		allocs := testing.AllocsPerRun(10, func() {
+			for range hof.WindowsSeq(slices.Values(input), 8, 1) {
+			}
+		})
+		if allocs > 4 {
+			t.Errorf("WindowsSeq allocated %.0f times, want a constant", allocs)
+		}
+	})
+
+	t.Run("copy windows are independent", func(t *testing.T) {
+		got := slices.Collect(hof.WindowsSeqCopy(slices.Values([]int{1, 2, 3, 4}), 2, 1))
+		want := [][]int{{1, 2}, {2, 3}, {3, 4}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got:%v\nwant:%v", got, want)
+		}
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		pulled := 0
+		for w := range hof.WindowsSeq(countingSeq(100, &pulled), 4, 2) {
+			if w[0] == 2 {
+				break
+			}
+		}
+		if pulled != 6 {
+			t.Errorf("pulled %d elements, want 6", pulled)
+		}
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	input := []int{1, 4, 9, 16}
+	want := [][2]any{{1, 4}, {4, 9}, {9, 16}}
+
+	if got := collect2(hof.Pairwise(input)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Pairwise got:%v\nwant:%v", got, want)
+	}
+	if got := collect2(hof.PairwiseSeq(slices.Values(input))); !reflect.DeepEqual(got, want) {
+		t.Errorf("PairwiseSeq got:%v\nwant:%v", got, want)
+	}
+	if got := collect2(hof.Pairwise([]int{1})); got != nil {
+		t.Errorf("Pairwise of single element = %v, want empty", got)
+	}
+	if got := collect2(hof.PairwiseSeq(slices.Values([]int{}))); got != nil {
+		t.Errorf("PairwiseSeq of empty = %v, want empty", got)
+	}
+}